@@ -8,10 +8,12 @@ import (
 )
 
 type Config struct {
-	Server   ServerConfig   `toml:"server"`
-	Github   GithubConfig   `toml:"github"`
-	Database DatabaseConfig `toml:"database"`
-	Log      LogConfig      `toml:"log"`
+	Server    ServerConfig     `toml:"server"`
+	Github    GithubConfig     `toml:"github"`
+	Database  DatabaseConfig   `toml:"database"`
+	Log       LogConfig        `toml:"log"`
+	Scheduler SchedulerConfig  `toml:"scheduler"`
+	Providers []ProviderConfig `toml:"providers"`
 }
 
 type ServerConfig struct {
@@ -27,8 +29,61 @@ type ServerConfig struct {
 }
 
 type GithubConfig struct {
-	Repositories []string `toml:"repositories"`
-	Token        string   `toml:"token"`
+	Repositories  []string `toml:"repositories"`
+	Token         string   `toml:"token"`
+	Tokens        []string `toml:"tokens"`         // additional tokens, each request uses the one with the most remaining quota
+	APIURL        string   `toml:"api_url"`        // default https://api.github.com
+	BackfillSince string   `toml:"backfill_since"` // lower bound for history backfill, e.g. "2020-01-01"
+
+	// App authenticates as a GitHub App where it is installed, the token is
+	// used for all other owners
+	App GitHubAppConfig `toml:"app"`
+
+	Concurrency      int    `toml:"concurrency"`         // repositories synced in parallel, default 4
+	MaxRetries       int    `toml:"max_retries"`         // retries for 5xx and secondary rate limits, default 3
+	MaxRateLimitWait string `toml:"max_rate_limit_wait"` // longest pause for a quota reset, default "15m"
+
+	// CommitDetails fetches the files, additions, deletions and parents of
+	// every new commit, at the cost of one request per commit
+	CommitDetails bool `toml:"commit_details"`
+
+	Branches  []BranchSync    `toml:"branches"`
+	Discovery DiscoveryConfig `toml:"discovery"`
+}
+
+// GitHubAppConfig identifies a GitHub App. Installation tokens are created
+// per organization or user from the app ID and private key.
+type GitHubAppConfig struct {
+	AppID          int64  `toml:"app_id"`
+	PrivateKeyPath string `toml:"private_key_path"` // PEM file generated in the app settings
+}
+
+// DiscoveryConfig lists the repositories of organizations and users on every
+// sync, in addition to the configured repositories. Patterns are globs
+// matched against owner/name, or against the name if they contain no slash.
+type DiscoveryConfig struct {
+	Organizations []string `toml:"organizations"`
+	Users         []string `toml:"users"`
+	Include       []string `toml:"include"` // default all repositories
+	Exclude       []string `toml:"exclude"`
+	SkipArchived  bool     `toml:"skip_archived"`
+	SkipForks     bool     `toml:"skip_forks"`
+}
+
+// BranchSync selects branches whose commits are synced in addition to the
+// default branch.
+type BranchSync struct {
+	Repository string   `toml:"repository"`
+	Branches   []string `toml:"branches"`
+}
+
+// ProviderConfig adds a code hosting service besides github.com. Repository
+// URLs on its host are synced through it.
+type ProviderConfig struct {
+	Type   string `toml:"type"`    // github (Enterprise Server), gitlab or gitea (also for Forgejo)
+	Host   string `toml:"host"`    // web host of the repositories, e.g. gitlab.com
+	APIURL string `toml:"api_url"` // default https://<host>/api/v3, /api/v4 or /api/v1 by type
+	Token  string `toml:"token"`
 }
 
 type DatabaseConfig struct {
@@ -39,6 +94,21 @@ type LogConfig struct {
 	Level string `toml:"level"`
 }
 
+type SchedulerConfig struct {
+	Enable      bool               `toml:"enable"`
+	Schedule    string             `toml:"schedule"`     // "30m", "@every 6h", "@daily" or "0 */6 * * *"
+	Jitter      string             `toml:"jitter"`       // max random delay added to each run, e.g. "5m"
+	CommitLimit int                `toml:"commit_limit"` // commits fetched per repository, default 50
+	Overrides   []ScheduleOverride `toml:"overrides"`
+}
+
+// ScheduleOverride gives a single repository its own schedule.
+type ScheduleOverride struct {
+	Repository string `toml:"repository"`
+	Schedule   string `toml:"schedule"`
+	Disable    bool   `toml:"disable"`
+}
+
 var GlobalConfig *Config
 
 func LoadConfig(configPath string) error {
@@ -39,7 +39,22 @@ func main() {
 	defer db.Close()
 
 	// Initialize GitHub service
-	githubService := services.NewGitHubService(cfg.Github.Token)
+	githubService, err := services.NewGitHubService(cfg.Github, cfg.Providers, db)
+	if err != nil {
+		log.Fatalf("Failed to initialize GitHub service: %v", err)
+	}
+
+	jobs := services.NewJobManager(githubService, db)
+
+	// Start background sync scheduler
+	var scheduler *services.Scheduler
+	if cfg.Scheduler.Enable {
+		scheduler, err = services.NewScheduler(cfg.Scheduler, jobs)
+		if err != nil {
+			log.Fatalf("Failed to create scheduler: %v", err)
+		}
+		scheduler.Start()
+	}
 
 	// Setup graceful shutdown
 	quit := make(chan os.Signal, 1)
@@ -52,7 +67,7 @@ func main() {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			if err := server.StartHTTPServer(db, githubService); err != nil {
+			if err := server.StartHTTPServer(db, githubService, jobs); err != nil {
 				log.Printf("HTTP server error: %v", err)
 			}
 		}()
@@ -61,8 +76,8 @@ func main() {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			if err := server.StartHTTPServer(db, githubService); err != nil {
-				log.Printf("HTTP server error: %v", err)
+			if err := server.StartGRPCServer(db, githubService, jobs); err != nil {
+				log.Printf("gRPC server error: %v", err)
 			}
 		}()
 	}
@@ -79,6 +94,13 @@ func main() {
 	<-quit
 	log.Println("Shutting down servers...")
 
+	// Cancel running sync jobs so they are recorded as canceled
+	jobs.Shutdown()
+	if scheduler != nil {
+		scheduler.Stop()
+		log.Println("Scheduler stopped")
+	}
+
 	// Note: In a production environment, you would implement graceful shutdown
 	// for both HTTP and gRPC servers here
 
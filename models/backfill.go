@@ -0,0 +1,63 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// CommitBackfill records how far a full history backfill of a repository has
+// progressed so that it can resume after a restart.
+type CommitBackfill struct {
+	RepositoryFullName string    `json:"repository_full_name" db:"repository_full_name"`
+	LastSHA            string    `json:"last_sha" db:"last_sha"`
+	NextURL            string    `json:"next_url" db:"next_url"` // next page to fetch, empty when completed
+	Page               int       `json:"page" db:"page"`
+	Since              time.Time `json:"since" db:"since"`
+	Completed          bool      `json:"completed" db:"completed"`
+	UpdatedAt          time.Time `json:"updated_at" db:"updated_at"`
+}
+
+func (db *DB) createBackfillTable() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS commit_backfills (
+		repository_full_name TEXT PRIMARY KEY,
+		last_sha TEXT NOT NULL DEFAULT '',
+		next_url TEXT NOT NULL DEFAULT '',
+		page INTEGER DEFAULT 0,
+		since DATETIME,
+		completed BOOLEAN DEFAULT 0,
+		updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	);
+	`
+	_, err := db.conn.Exec(query)
+	return err
+}
+
+// GetCommitBackfill returns the backfill state of a repository, or nil if no
+// backfill was started yet.
+func (db *DB) GetCommitBackfill(repositoryFullName string) (*CommitBackfill, error) {
+	query := `SELECT repository_full_name, last_sha, next_url, page, since, completed, updated_at FROM commit_backfills WHERE repository_full_name = ?`
+	state := &CommitBackfill{}
+	err := db.conn.QueryRow(query, repositoryFullName).Scan(
+		&state.RepositoryFullName, &state.LastSHA, &state.NextURL, &state.Page,
+		&state.Since, &state.Completed, &state.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+func (db *DB) SaveCommitBackfill(state *CommitBackfill) error {
+	query := `
+	INSERT OR REPLACE INTO commit_backfills 
+	(repository_full_name, last_sha, next_url, page, since, completed, updated_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+	_, err := db.conn.Exec(query,
+		state.RepositoryFullName, state.LastSHA, state.NextURL, state.Page,
+		state.Since, state.Completed, time.Now())
+	return err
+}
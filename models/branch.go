@@ -0,0 +1,180 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+type Branch struct {
+	ID                 int       `json:"id" db:"id"`
+	RepositoryFullName string    `json:"repository_full_name" db:"repository_full_name"`
+	Name               string    `json:"name" db:"name"`
+	HeadSHA            string    `json:"head_sha" db:"head_sha"`
+	Protected          bool      `json:"protected" db:"protected"`
+	SyncedAt           time.Time `json:"synced_at" db:"synced_at"`
+}
+
+// createBranchTables creates the branch list and the commit_branches table,
+// which records the branches a commit was synced from. A commit can be on
+// several branches, so the branch is not a column of commits.
+func (db *DB) createBranchTables() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS branches (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		repository_full_name TEXT NOT NULL,
+		name TEXT NOT NULL,
+		head_sha TEXT NOT NULL,
+		protected BOOLEAN DEFAULT 0,
+		synced_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		UNIQUE(name, repository_full_name)
+	);
+
+	CREATE TABLE IF NOT EXISTS commit_branches (
+		sha TEXT NOT NULL,
+		repository_full_name TEXT NOT NULL,
+		branch TEXT NOT NULL,
+		PRIMARY KEY (sha, repository_full_name, branch)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_commit_branches_branch ON commit_branches(repository_full_name, branch);
+	`
+	_, err := db.conn.Exec(query)
+	return err
+}
+
+// ReplaceBranches stores the full branch list of a repository, removing
+// branches that were deleted on GitHub.
+func (db *DB) ReplaceBranches(repositoryFullName string, branches []*Branch) error {
+	tx, err := db.conn.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM branches WHERE repository_full_name = ?`, repositoryFullName); err != nil {
+		return err
+	}
+
+	query := `INSERT INTO branches (repository_full_name, name, head_sha, protected, synced_at) VALUES (?, ?, ?, ?, ?)`
+	now := time.Now()
+	for _, branch := range branches {
+		if _, err := tx.Exec(query, repositoryFullName, branch.Name, branch.HeadSHA, branch.Protected, now); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (db *DB) GetBranches(repositoryFullName string) ([]*Branch, error) {
+	query := `SELECT id, repository_full_name, name, head_sha, protected, synced_at
+			  FROM branches
+			  WHERE repository_full_name = ?
+			  ORDER BY name`
+	rows, err := db.conn.Query(query, repositoryFullName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var branches []*Branch
+	for rows.Next() {
+		branch := &Branch{}
+		err := rows.Scan(&branch.ID, &branch.RepositoryFullName, &branch.Name,
+			&branch.HeadSHA, &branch.Protected, &branch.SyncedAt)
+		if err != nil {
+			return nil, err
+		}
+		branches = append(branches, branch)
+	}
+
+	return branches, nil
+}
+
+// SaveBranchCommit stores a commit and records that it is on branch.
+func (db *DB) SaveBranchCommit(commit *Commit, branch string) error {
+	if err := db.SaveCommit(commit); err != nil {
+		return err
+	}
+	query := `INSERT OR IGNORE INTO commit_branches (sha, repository_full_name, branch) VALUES (?, ?, ?)`
+	_, err := db.conn.Exec(query, commit.SHA, commit.RepositoryFullName, branch)
+	return err
+}
+
+func (db *DB) HasBranchCommit(sha, repositoryFullName, branch string) (bool, error) {
+	query := `SELECT COUNT(*) FROM commit_branches WHERE sha = ? AND repository_full_name = ? AND branch = ?`
+	var count int
+	err := db.conn.QueryRow(query, sha, repositoryFullName, branch).Scan(&count)
+	return count > 0, err
+}
+
+// GetLatestBranchCommitDate returns the date of the newest stored commit of a
+// branch, or the zero time if none is stored. Like GetBranchCommits, commits
+// without any branch count for the default branch.
+func (db *DB) GetLatestBranchCommitDate(repositoryFullName, branch string) (time.Time, error) {
+	query := `SELECT c.commit_date
+			  FROM commits c
+			  WHERE c.repository_full_name = ? AND ` + branchCommitFilter + `
+			  ORDER BY c.commit_date DESC
+			  LIMIT 1`
+	var latest time.Time
+	err := db.conn.QueryRow(query, repositoryFullName, branch, branch).Scan(&latest)
+	if err == sql.ErrNoRows {
+		return time.Time{}, nil
+	}
+	return latest, err
+}
+
+// GetDefaultBranch returns the default branch of a stored repository, or ""
+// if the repository is not stored.
+func (db *DB) GetDefaultBranch(repositoryFullName string) (string, error) {
+	var branch string
+	err := db.conn.QueryRow(`SELECT default_branch FROM repositories WHERE full_name = ?`, repositoryFullName).Scan(&branch)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return branch, err
+}
+
+// branchCommitFilter selects the commits c of a branch, taking the branch
+// twice. Commits without any branch count as commits of the default branch:
+// they were synced before its name was known, by a backfill or from a
+// provider other than GitHub.
+const branchCommitFilter = `(EXISTS (SELECT 1 FROM commit_branches b
+				  WHERE b.sha = c.sha AND b.repository_full_name = c.repository_full_name AND b.branch = ?)
+			  OR (? = (SELECT default_branch FROM repositories WHERE full_name = c.repository_full_name)
+				  AND NOT EXISTS (SELECT 1 FROM commit_branches b
+				  WHERE b.sha = c.sha AND b.repository_full_name = c.repository_full_name)))`
+
+func (db *DB) GetBranchCommits(repositoryFullName, branch string, limit, offset int) ([]*Commit, error) {
+	query := `SELECT c.id, c.sha, c.message, c.author_name, c.author_email, c.commit_date, c.repository_full_name, c.synced_at
+			  FROM commits c
+			  WHERE c.repository_full_name = ? AND ` + branchCommitFilter + `
+			  ORDER BY c.commit_date DESC
+			  LIMIT ? OFFSET ?`
+	rows, err := db.conn.Query(query, repositoryFullName, branch, branch, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var commits []*Commit
+	for rows.Next() {
+		commit := &Commit{}
+		err := rows.Scan(&commit.ID, &commit.SHA, &commit.Message, &commit.AuthorName,
+			&commit.AuthorEmail, &commit.CommitDate, &commit.RepositoryFullName, &commit.SyncedAt)
+		if err != nil {
+			return nil, err
+		}
+		commits = append(commits, commit)
+	}
+
+	return commits, nil
+}
+
+func (db *DB) GetBranchCommitCount(repositoryFullName, branch string) (int, error) {
+	query := `SELECT COUNT(*) FROM commits c WHERE c.repository_full_name = ? AND ` + branchCommitFilter
+	var count int
+	err := db.conn.QueryRow(query, repositoryFullName, branch, branch).Scan(&count)
+	return count, err
+}
@@ -0,0 +1,103 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBranchCommits(t *testing.T) {
+	db := newTestDB(t)
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 12, 0, 0, 0, time.UTC) }
+	commit := func(sha string, d int) *Commit {
+		return &Commit{SHA: sha, RepositoryFullName: "o/r", AuthorEmail: "a@example.com", CommitDate: day(d)}
+	}
+
+	if err := db.SaveRepository(&Repository{Name: "r", FullName: "o/r", DefaultBranch: "main"}); err != nil {
+		t.Fatal(err)
+	}
+	// backfilled was stored without a branch, e.g. by a backfill
+	steps := []struct {
+		commit *Commit
+		branch string
+	}{
+		{commit("backfilled", 1), ""},
+		{commit("m1", 2), "main"},
+		{commit("shared", 3), "main"},
+		{commit("shared", 3), "feature"},
+		{commit("f1", 5), "feature"},
+	}
+	for _, s := range steps {
+		var err error
+		if s.branch == "" {
+			err = db.SaveCommit(s.commit)
+		} else {
+			err = db.SaveBranchCommit(s.commit, s.branch)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		branch string
+		want   []string
+		latest time.Time
+	}{
+		{"main", []string{"shared", "m1", "backfilled"}, day(3)},
+		{"feature", []string{"f1", "shared"}, day(5)},
+		{"unknown", nil, time.Time{}},
+	}
+	for _, tt := range tests {
+		commits, err := db.GetBranchCommits("o/r", tt.branch, 10, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, c := range commits {
+			got = append(got, c.SHA)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("GetBranchCommits(%s) = %v, want %v", tt.branch, got, tt.want)
+		}
+		if count, err := db.GetBranchCommitCount("o/r", tt.branch); err != nil || count != len(tt.want) {
+			t.Errorf("GetBranchCommitCount(%s) = %d, %v, want %d", tt.branch, count, err, len(tt.want))
+		}
+		if latest, err := db.GetLatestBranchCommitDate("o/r", tt.branch); err != nil || !latest.Equal(tt.latest) {
+			t.Errorf("GetLatestBranchCommitDate(%s) = %s, %v, want %s", tt.branch, latest, err, tt.latest)
+		}
+	}
+
+	if ok, err := db.HasBranchCommit("shared", "o/r", "feature"); err != nil || !ok {
+		t.Errorf("HasBranchCommit(shared, feature) = %v, %v", ok, err)
+	}
+	if ok, err := db.HasBranchCommit("f1", "o/r", "main"); err != nil || ok {
+		t.Errorf("HasBranchCommit(f1, main) = %v, %v", ok, err)
+	}
+}
+
+func TestLatestDefaultBranchCommitDateWithoutBranches(t *testing.T) {
+	db := newTestDB(t)
+	if err := db.SaveRepository(&Repository{Name: "r", FullName: "o/r", DefaultBranch: "main"}); err != nil {
+		t.Fatal(err)
+	}
+	// Only commits synced before the branch was known
+	date := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	if err := db.SaveCommit(&Commit{SHA: "a", RepositoryFullName: "o/r", CommitDate: date}); err != nil {
+		t.Fatal(err)
+	}
+
+	latest, err := db.GetLatestBranchCommitDate("o/r", "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !latest.Equal(date) {
+		t.Errorf("latest = %s, want %s", latest, date)
+	}
+	if branch, err := db.GetDefaultBranch("o/r"); err != nil || branch != "main" {
+		t.Errorf("GetDefaultBranch() = %q, %v", branch, err)
+	}
+	if branch, err := db.GetDefaultBranch("o/unknown"); err != nil || branch != "" {
+		t.Errorf("GetDefaultBranch(unknown) = %q, %v", branch, err)
+	}
+}
@@ -0,0 +1,197 @@
+package models
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+)
+
+// CommitDetail is a commit with the statistics fetched from /commits/:sha.
+// DetailsSyncedAt is nil if the details were not fetched.
+type CommitDetail struct {
+	Commit
+	Additions       int           `json:"additions" db:"additions"`
+	Deletions       int           `json:"deletions" db:"deletions"`
+	Parents         []string      `json:"parents" db:"parents"`
+	Files           []*CommitFile `json:"files"`
+	DetailsSyncedAt *time.Time    `json:"details_synced_at" db:"details_synced_at"`
+}
+
+type CommitFile struct {
+	Filename  string `json:"filename" db:"filename"`
+	Status    string `json:"status" db:"status"` // added, modified, removed, renamed, ...
+	Additions int    `json:"additions" db:"additions"`
+	Deletions int    `json:"deletions" db:"deletions"`
+}
+
+// FileChurn sums the changes of a file over the detailed commits.
+type FileChurn struct {
+	Filename  string `json:"filename"`
+	Commits   int    `json:"commits"`
+	Additions int    `json:"additions"`
+	Deletions int    `json:"deletions"`
+}
+
+// ChurnStats sums the changes of the detailed commits of a repository.
+type ChurnStats struct {
+	RepositoryFullName string       `json:"repository_full_name"`
+	Commits            int          `json:"commits"`
+	Additions          int          `json:"additions"`
+	Deletions          int          `json:"deletions"`
+	FilesChanged       int          `json:"files_changed"`
+	TopFiles           []*FileChurn `json:"top_files"`
+}
+
+func (db *DB) createCommitDetailTables() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS commit_files (
+		sha TEXT NOT NULL,
+		repository_full_name TEXT NOT NULL,
+		filename TEXT NOT NULL,
+		status TEXT NOT NULL DEFAULT '',
+		additions INTEGER DEFAULT 0,
+		deletions INTEGER DEFAULT 0,
+		PRIMARY KEY (sha, repository_full_name, filename)
+	);
+	`
+	if _, err := db.conn.Exec(query); err != nil {
+		return err
+	}
+
+	// The detail columns were added after the commits table was introduced
+	for _, column := range []struct{ name, definition string }{
+		{"additions", "INTEGER DEFAULT 0"},
+		{"deletions", "INTEGER DEFAULT 0"},
+		{"parents", "TEXT NOT NULL DEFAULT '[]'"},
+		{"details_synced_at", "DATETIME"},
+	} {
+		if err := db.addColumn("commits", column.name, column.definition); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HasCommitDetails reports whether the details of a commit were fetched.
+func (db *DB) HasCommitDetails(sha, repositoryFullName string) (bool, error) {
+	query := `SELECT COUNT(*) FROM commits WHERE sha = ? AND repository_full_name = ? AND details_synced_at IS NOT NULL`
+	var count int
+	err := db.conn.QueryRow(query, sha, repositoryFullName).Scan(&count)
+	return count > 0, err
+}
+
+// SaveCommitDetails stores the details of an already stored commit.
+func (db *DB) SaveCommitDetails(detail *CommitDetail) error {
+	tx, err := db.conn.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	parents, _ := json.Marshal(nonNil(detail.Parents))
+	query := `UPDATE commits SET additions = ?, deletions = ?, parents = ?, details_synced_at = ?
+			  WHERE sha = ? AND repository_full_name = ?`
+	_, err = tx.Exec(query, detail.Additions, detail.Deletions, string(parents), time.Now(),
+		detail.SHA, detail.RepositoryFullName)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(`DELETE FROM commit_files WHERE sha = ? AND repository_full_name = ?`, detail.SHA, detail.RepositoryFullName); err != nil {
+		return err
+	}
+	query = `INSERT INTO commit_files (sha, repository_full_name, filename, status, additions, deletions) VALUES (?, ?, ?, ?, ?, ?)`
+	for _, f := range detail.Files {
+		if _, err := tx.Exec(query, detail.SHA, detail.RepositoryFullName, f.Filename, f.Status, f.Additions, f.Deletions); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetCommitDetail returns a stored commit with its details, or nil if the
+// commit is not stored.
+func (db *DB) GetCommitDetail(repositoryFullName, sha string) (*CommitDetail, error) {
+	query := `SELECT id, sha, message, author_name, author_email, commit_date, repository_full_name, synced_at,
+			  additions, deletions, parents, details_synced_at
+			  FROM commits
+			  WHERE repository_full_name = ? AND sha = ?`
+	d := &CommitDetail{}
+	var parents string
+	var detailsSyncedAt sql.NullTime
+	err := db.conn.QueryRow(query, repositoryFullName, sha).Scan(&d.ID, &d.SHA, &d.Message, &d.AuthorName,
+		&d.AuthorEmail, &d.CommitDate, &d.RepositoryFullName, &d.SyncedAt,
+		&d.Additions, &d.Deletions, &parents, &detailsSyncedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	json.Unmarshal([]byte(parents), &d.Parents)
+	if detailsSyncedAt.Valid {
+		d.DetailsSyncedAt = &detailsSyncedAt.Time
+	}
+
+	query = `SELECT filename, status, additions, deletions
+			 FROM commit_files
+			 WHERE sha = ? AND repository_full_name = ?
+			 ORDER BY filename`
+	rows, err := db.conn.Query(query, sha, repositoryFullName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		f := &CommitFile{}
+		if err := rows.Scan(&f.Filename, &f.Status, &f.Additions, &f.Deletions); err != nil {
+			return nil, err
+		}
+		d.Files = append(d.Files, f)
+	}
+
+	return d, nil
+}
+
+// GetChurnStats sums the changes of the detailed commits of a repository made
+// since the given time, with the limit most changed files.
+func (db *DB) GetChurnStats(repositoryFullName string, since time.Time, limit int) (*ChurnStats, error) {
+	stats := &ChurnStats{RepositoryFullName: repositoryFullName}
+
+	query := `SELECT COUNT(*), COALESCE(SUM(additions), 0), COALESCE(SUM(deletions), 0)
+			  FROM commits
+			  WHERE repository_full_name = ? AND details_synced_at IS NOT NULL AND commit_date >= ?`
+	err := db.conn.QueryRow(query, repositoryFullName, since).Scan(&stats.Commits, &stats.Additions, &stats.Deletions)
+	if err != nil {
+		return nil, err
+	}
+
+	files := `FROM commit_files f
+			  JOIN commits c ON c.sha = f.sha AND c.repository_full_name = f.repository_full_name
+			  WHERE f.repository_full_name = ? AND c.commit_date >= ?`
+	if err := db.conn.QueryRow(`SELECT COUNT(DISTINCT f.filename) `+files, repositoryFullName, since).Scan(&stats.FilesChanged); err != nil {
+		return nil, err
+	}
+
+	query = `SELECT f.filename, COUNT(*), SUM(f.additions), SUM(f.deletions) ` + files + `
+			 GROUP BY f.filename
+			 ORDER BY SUM(f.additions) + SUM(f.deletions) DESC, f.filename
+			 LIMIT ?`
+	rows, err := db.conn.Query(query, repositoryFullName, since, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		f := &FileChurn{}
+		if err := rows.Scan(&f.Filename, &f.Commits, &f.Additions, &f.Deletions); err != nil {
+			return nil, err
+		}
+		stats.TopFiles = append(stats.TopFiles, f)
+	}
+
+	return stats, nil
+}
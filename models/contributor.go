@@ -0,0 +1,254 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// Contributor is a GitHub contributor of a repository. Additions and
+// deletions come from the contributor statistics, which GitHub computes in
+// the background and may not be available on the first sync.
+type Contributor struct {
+	ID                 int       `json:"id" db:"id"`
+	RepositoryFullName string    `json:"repository_full_name" db:"repository_full_name"`
+	Login              string    `json:"login" db:"login"`
+	AvatarURL          string    `json:"avatar_url" db:"avatar_url"`
+	URL                string    `json:"url" db:"url"`
+	Contributions      int       `json:"contributions" db:"contributions"`
+	Additions          int       `json:"additions" db:"additions"`
+	Deletions          int       `json:"deletions" db:"deletions"`
+	SyncedAt           time.Time `json:"synced_at" db:"synced_at"`
+}
+
+// ContributorStats holds the totals of the GitHub contributor statistics.
+type ContributorStats struct {
+	Login     string
+	Additions int
+	Deletions int
+}
+
+// AuthorStats is derived from the stored commits, grouped by author email.
+// Additions and deletions only cover the commits whose details were synced.
+type AuthorStats struct {
+	Name            string    `json:"name"`
+	Email           string    `json:"email"`
+	Commits         int       `json:"commits"`
+	FirstCommit     time.Time `json:"first_commit"`
+	LastCommit      time.Time `json:"last_commit"`
+	Additions       int       `json:"additions"`
+	Deletions       int       `json:"deletions"`
+	DetailedCommits int       `json:"detailed_commits"` // commits with synced details
+}
+
+// LeaderboardEntry sums a contributor over all repositories.
+type LeaderboardEntry struct {
+	Login         string `json:"login"`
+	AvatarURL     string `json:"avatar_url"`
+	Contributions int    `json:"contributions"`
+	Additions     int    `json:"additions"`
+	Deletions     int    `json:"deletions"`
+	Repositories  int    `json:"repositories"`
+}
+
+func (db *DB) createContributorTable() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS contributors (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		repository_full_name TEXT NOT NULL,
+		login TEXT NOT NULL,
+		avatar_url TEXT NOT NULL DEFAULT '',
+		url TEXT NOT NULL DEFAULT '',
+		contributions INTEGER DEFAULT 0,
+		additions INTEGER DEFAULT 0,
+		deletions INTEGER DEFAULT 0,
+		synced_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		UNIQUE(login, repository_full_name)
+	);
+	`
+	_, err := db.conn.Exec(query)
+	return err
+}
+
+// ReplaceContributors stores the contributor list of a repository and removes
+// contributors no longer listed. Stored additions and deletions are kept until
+// new statistics are saved with UpdateContributorStats.
+func (db *DB) ReplaceContributors(repositoryFullName string, contributors []*Contributor) error {
+	tx, err := db.conn.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+	INSERT INTO contributors
+	(repository_full_name, login, avatar_url, url, contributions, synced_at)
+	VALUES (?, ?, ?, ?, ?, ?)
+	ON CONFLICT(login, repository_full_name) DO UPDATE SET
+		avatar_url = excluded.avatar_url,
+		url = excluded.url,
+		contributions = excluded.contributions,
+		synced_at = excluded.synced_at
+	`
+	now := time.Now()
+	for _, c := range contributors {
+		if _, err := tx.Exec(query, repositoryFullName, c.Login, c.AvatarURL, c.URL, c.Contributions, now); err != nil {
+			return err
+		}
+	}
+
+	// Everything not touched above is no longer a contributor
+	if _, err := tx.Exec(`DELETE FROM contributors WHERE repository_full_name = ? AND synced_at <> ?`, repositoryFullName, now); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UpdateContributorStats stores the additions and deletions of contributors.
+func (db *DB) UpdateContributorStats(repositoryFullName string, stats []*ContributorStats) error {
+	tx, err := db.conn.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE contributors SET additions = ?, deletions = ? WHERE login = ? AND repository_full_name = ?`
+	for _, s := range stats {
+		if _, err := tx.Exec(query, s.Additions, s.Deletions, s.Login, repositoryFullName); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (db *DB) GetContributors(repositoryFullName string) ([]*Contributor, error) {
+	query := `SELECT id, repository_full_name, login, avatar_url, url, contributions, additions, deletions, synced_at
+			  FROM contributors
+			  WHERE repository_full_name = ?
+			  ORDER BY contributions DESC`
+	rows, err := db.conn.Query(query, repositoryFullName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var contributors []*Contributor
+	for rows.Next() {
+		c := &Contributor{}
+		err := rows.Scan(&c.ID, &c.RepositoryFullName, &c.Login, &c.AvatarURL, &c.URL,
+			&c.Contributions, &c.Additions, &c.Deletions, &c.SyncedAt)
+		if err != nil {
+			return nil, err
+		}
+		contributors = append(contributors, c)
+	}
+
+	return contributors, nil
+}
+
+// GetAuthorStats derives per-author commit counts, first/last commit dates and
+// the additions and deletions of the detailed commits from the stored commits
+// of a repository.
+func (db *DB) GetAuthorStats(repositoryFullName string) ([]*AuthorStats, error) {
+	query := `SELECT MAX(author_name), author_email, COUNT(*), MIN(commit_date), MAX(commit_date),
+			  COALESCE(SUM(CASE WHEN details_synced_at IS NOT NULL THEN additions END), 0),
+			  COALESCE(SUM(CASE WHEN details_synced_at IS NOT NULL THEN deletions END), 0),
+			  COUNT(details_synced_at)
+			  FROM commits
+			  WHERE repository_full_name = ?
+			  GROUP BY author_email
+			  ORDER BY COUNT(*) DESC`
+	rows, err := db.conn.Query(query, repositoryFullName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var authors []*AuthorStats
+	for rows.Next() {
+		a := &AuthorStats{}
+		var first, last string
+		if err := rows.Scan(&a.Name, &a.Email, &a.Commits, &first, &last,
+			&a.Additions, &a.Deletions, &a.DetailedCommits); err != nil {
+			return nil, err
+		}
+		// Aggregates lose the column type, so dates come back as text
+		if a.FirstCommit, err = parseTimestamp(first); err != nil {
+			return nil, err
+		}
+		if a.LastCommit, err = parseTimestamp(last); err != nil {
+			return nil, err
+		}
+		authors = append(authors, a)
+	}
+
+	return authors, nil
+}
+
+// leaderboardOrders maps the accepted sort keys to their ORDER BY column.
+var leaderboardOrders = map[string]string{
+	"contributions": "contributions",
+	"additions":     "additions",
+	"deletions":     "deletions",
+	"repositories":  "repositories",
+}
+
+// GetContributorLeaderboard ranks contributors across all repositories, or
+// across the repositories of owner if set. Owners outside github.com are
+// qualified with their host like full names, e.g. gitea.com/gitea, and a
+// bare host selects all repositories of that host. sortBy is one of
+// contributions, additions, deletions or repositories.
+func (db *DB) GetContributorLeaderboard(owner, sortBy string, limit int) ([]*LeaderboardEntry, error) {
+	order, ok := leaderboardOrders[sortBy]
+	if !ok {
+		return nil, fmt.Errorf("invalid sort %q", sortBy)
+	}
+
+	query := `SELECT login, MAX(avatar_url), SUM(contributions) AS contributions, SUM(additions) AS additions,
+			  SUM(deletions) AS deletions, COUNT(*) AS repositories
+			  FROM contributors
+			  WHERE ? = '' OR repository_full_name LIKE ? ESCAPE '\'
+			  GROUP BY login
+			  ORDER BY ` + order + ` DESC, login
+			  LIMIT ?`
+	rows, err := db.conn.Query(query, owner, escapeLike(owner)+"/%", limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*LeaderboardEntry
+	for rows.Next() {
+		e := &LeaderboardEntry{}
+		err := rows.Scan(&e.Login, &e.AvatarURL, &e.Contributions, &e.Additions, &e.Deletions, &e.Repositories)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+
+	return entries, nil
+}
+
+// parseTimestamp parses a timestamp stored by the sqlite3 driver. Columns that
+// keep their DATETIME type are converted by database/sql to RFC 3339 instead.
+func parseTimestamp(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return t, nil
+	}
+	for _, format := range sqlite3.SQLiteTimestampFormats {
+		if t, err := time.ParseInLocation(format, s, time.UTC); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid timestamp %q", s)
+}
+
+// escapeLike escapes the LIKE wildcards in s.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
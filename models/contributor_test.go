@@ -0,0 +1,63 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetContributorLeaderboard(t *testing.T) {
+	db := newTestDB(t)
+
+	repos := map[string][]*Contributor{
+		"o/a":                    {{Login: "alice", Contributions: 10}, {Login: "bob", Contributions: 3}},
+		"o/b":                    {{Login: "bob", Contributions: 4}},
+		"other/c":                {{Login: "carol", Contributions: 50}},
+		"ghe.example.com/o/d":    {{Login: "alice", Contributions: 5}},
+		"gitlab.com/g/sub/e":     {{Login: "dave", Contributions: 7}},
+		"gitea.com/gitea/tea":    {{Login: "erin", Contributions: 2}},
+		"gitea.com/gitea2/other": {{Login: "frank", Contributions: 1}},
+	}
+	for fullName, contributors := range repos {
+		if err := db.ReplaceContributors(fullName, contributors); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		owner string
+		want  []string
+	}{
+		{"", []string{"carol", "alice", "bob", "dave", "erin", "frank"}},
+		{"o", []string{"alice", "bob"}},
+		{"ghe.example.com/o", []string{"alice"}},
+		{"gitlab.com/g", []string{"dave"}},
+		{"gitlab.com/g/sub", []string{"dave"}},
+		{"gitea.com/gitea", []string{"erin"}},
+		{"gitea.com", []string{"erin", "frank"}},
+		{"unknown", nil},
+	}
+	for _, tt := range tests {
+		entries, err := db.GetContributorLeaderboard(tt.owner, "contributions", 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, e := range entries {
+			got = append(got, e.Login)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("GetContributorLeaderboard(%q) = %v, want %v", tt.owner, got, tt.want)
+		}
+	}
+
+	entries, err := db.GetContributorLeaderboard("", "repositories", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Login != "alice" || entries[0].Repositories != 2 || entries[0].Contributions != 15 {
+		t.Errorf("top by repositories = %+v, want alice in 2 repositories", entries[0])
+	}
+	if _, err := db.GetContributorLeaderboard("", "stars", 10); err == nil {
+		t.Error("invalid sort accepted")
+	}
+}
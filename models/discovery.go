@@ -0,0 +1,131 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// DiscoveredRepository is a repository found by listing an organization or
+// user. DisappearedAt is set once the repository is no longer listed, e.g.
+// because it was deleted, renamed or transferred.
+type DiscoveredRepository struct {
+	Host          string     `json:"host" db:"host"` // e.g. github.com
+	FullName      string     `json:"full_name" db:"full_name"`
+	Owner         string     `json:"owner" db:"owner"`
+	URL           string     `json:"url" db:"url"`
+	Archived      bool       `json:"archived" db:"archived"`
+	Fork          bool       `json:"fork" db:"fork"`
+	FirstSeenAt   time.Time  `json:"first_seen_at" db:"first_seen_at"`
+	LastSeenAt    time.Time  `json:"last_seen_at" db:"last_seen_at"`
+	DisappearedAt *time.Time `json:"disappeared_at" db:"disappeared_at"`
+}
+
+func (db *DB) createDiscoveryTable() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS discovered_repositories (
+		host TEXT NOT NULL,
+		full_name TEXT NOT NULL,
+		owner TEXT NOT NULL,
+		url TEXT NOT NULL,
+		archived BOOLEAN DEFAULT 0,
+		fork BOOLEAN DEFAULT 0,
+		first_seen_at DATETIME NOT NULL,
+		last_seen_at DATETIME NOT NULL,
+		disappeared_at DATETIME,
+		PRIMARY KEY (host, full_name)
+	);
+	`
+	_, err := db.conn.Exec(query)
+	return err
+}
+
+// RecordDiscovery stores the complete repository listing of an owner on a
+// host and marks the repositories of that owner that are no longer listed as
+// disappeared. It returns the full names of the newly disappeared
+// repositories.
+func (db *DB) RecordDiscovery(host, owner string, repos []*DiscoveredRepository) ([]string, error) {
+	tx, err := db.conn.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	query := `
+	INSERT INTO discovered_repositories
+	(host, full_name, owner, url, archived, fork, first_seen_at, last_seen_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	ON CONFLICT(host, full_name) DO UPDATE SET
+		owner = excluded.owner,
+		url = excluded.url,
+		archived = excluded.archived,
+		fork = excluded.fork,
+		last_seen_at = excluded.last_seen_at,
+		disappeared_at = NULL
+	`
+	now := time.Now()
+	for _, r := range repos {
+		if _, err := tx.Exec(query, host, r.FullName, owner, r.URL, r.Archived, r.Fork, now, now); err != nil {
+			return nil, err
+		}
+	}
+
+	// Everything of this owner not touched above is gone
+	rows, err := tx.Query(`SELECT full_name FROM discovered_repositories
+						   WHERE host = ? AND owner = ? AND last_seen_at <> ? AND disappeared_at IS NULL`, host, owner, now)
+	if err != nil {
+		return nil, err
+	}
+	var disappeared []string
+	for rows.Next() {
+		var fullName string
+		if err := rows.Scan(&fullName); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		disappeared = append(disappeared, fullName)
+	}
+	rows.Close()
+
+	_, err = tx.Exec(`UPDATE discovered_repositories SET disappeared_at = ?
+					  WHERE host = ? AND owner = ? AND last_seen_at <> ? AND disappeared_at IS NULL`, now, host, owner, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return disappeared, tx.Commit()
+}
+
+// GetDiscoveredRepositories returns the discovered repositories by host and
+// name, or only the disappeared ones, most recent first.
+func (db *DB) GetDiscoveredRepositories(onlyDisappeared bool) ([]*DiscoveredRepository, error) {
+	query := `SELECT host, full_name, owner, url, archived, fork, first_seen_at, last_seen_at, disappeared_at
+			  FROM discovered_repositories
+			  ORDER BY host, full_name`
+	if onlyDisappeared {
+		query = `SELECT host, full_name, owner, url, archived, fork, first_seen_at, last_seen_at, disappeared_at
+				 FROM discovered_repositories
+				 WHERE disappeared_at IS NOT NULL
+				 ORDER BY disappeared_at DESC`
+	}
+	rows, err := db.conn.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var repos []*DiscoveredRepository
+	for rows.Next() {
+		r := &DiscoveredRepository{}
+		var disappearedAt sql.NullTime
+		err := rows.Scan(&r.Host, &r.FullName, &r.Owner, &r.URL, &r.Archived, &r.Fork, &r.FirstSeenAt, &r.LastSeenAt, &disappearedAt)
+		if err != nil {
+			return nil, err
+		}
+		if disappearedAt.Valid {
+			r.DisappearedAt = &disappearedAt.Time
+		}
+		repos = append(repos, r)
+	}
+
+	return repos, nil
+}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// HTTPCacheEntry holds the validators of the last response for an API URL so
+// that subsequent requests can be made conditional.
+type HTTPCacheEntry struct {
+	URL          string    `json:"url" db:"url"`
+	ETag         string    `json:"etag" db:"etag"`
+	LastModified string    `json:"last_modified" db:"last_modified"`
+	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+}
+
+func (db *DB) createHTTPCacheTable() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS http_cache (
+		url TEXT PRIMARY KEY,
+		etag TEXT NOT NULL DEFAULT '',
+		last_modified TEXT NOT NULL DEFAULT '',
+		updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	);
+	`
+	_, err := db.conn.Exec(query)
+	return err
+}
+
+// GetHTTPCache returns the cached validators for a URL, or nil if none.
+func (db *DB) GetHTTPCache(url string) (*HTTPCacheEntry, error) {
+	query := `SELECT url, etag, last_modified, updated_at FROM http_cache WHERE url = ?`
+	entry := &HTTPCacheEntry{}
+	err := db.conn.QueryRow(query, url).Scan(&entry.URL, &entry.ETag, &entry.LastModified, &entry.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+func (db *DB) SaveHTTPCache(entry *HTTPCacheEntry) error {
+	query := `
+	INSERT OR REPLACE INTO http_cache 
+	(url, etag, last_modified, updated_at)
+	VALUES (?, ?, ?, ?)
+	`
+	_, err := db.conn.Exec(query, entry.URL, entry.ETag, entry.LastModified, time.Now())
+	return err
+}
+
+func (db *DB) DeleteHTTPCache(url string) error {
+	_, err := db.conn.Exec(`DELETE FROM http_cache WHERE url = ?`, url)
+	return err
+}
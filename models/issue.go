@@ -0,0 +1,253 @@
+package models
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type Issue struct {
+	ID                 int        `json:"id" db:"id"`
+	RepositoryFullName string     `json:"repository_full_name" db:"repository_full_name"`
+	Number             int        `json:"number" db:"number"`
+	Title              string     `json:"title" db:"title"`
+	State              string     `json:"state" db:"state"` // open or closed
+	Author             string     `json:"author" db:"author"`
+	Labels             []string   `json:"labels" db:"labels"`
+	Assignees          []string   `json:"assignees" db:"assignees"`
+	Comments           int        `json:"comments" db:"comments"`
+	URL                string     `json:"url" db:"url"`
+	CreatedAt          time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt          time.Time  `json:"updated_at" db:"updated_at"`
+	ClosedAt           *time.Time `json:"closed_at" db:"closed_at"`
+	SyncedAt           time.Time  `json:"synced_at" db:"synced_at"`
+}
+
+type PullRequest struct {
+	Issue
+	Draft    bool       `json:"draft" db:"draft"`
+	MergedAt *time.Time `json:"merged_at" db:"merged_at"`
+}
+
+// IssueFilter selects issues or pull requests. Empty fields match everything.
+type IssueFilter struct {
+	Repository string
+	State      string // open or closed; merged for pull requests
+	Label      string
+	Author     string
+	Limit      int
+	Offset     int
+}
+
+func (db *DB) createIssueTables() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS issues (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		repository_full_name TEXT NOT NULL,
+		number INTEGER NOT NULL,
+		title TEXT NOT NULL,
+		state TEXT NOT NULL,
+		author TEXT NOT NULL DEFAULT '',
+		labels TEXT NOT NULL DEFAULT '[]',
+		assignees TEXT NOT NULL DEFAULT '[]',
+		comments INTEGER DEFAULT 0,
+		url TEXT NOT NULL DEFAULT '',
+		created_at DATETIME,
+		updated_at DATETIME,
+		closed_at DATETIME,
+		synced_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		UNIQUE(number, repository_full_name)
+	);
+
+	CREATE TABLE IF NOT EXISTS pull_requests (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		repository_full_name TEXT NOT NULL,
+		number INTEGER NOT NULL,
+		title TEXT NOT NULL,
+		state TEXT NOT NULL,
+		author TEXT NOT NULL DEFAULT '',
+		labels TEXT NOT NULL DEFAULT '[]',
+		assignees TEXT NOT NULL DEFAULT '[]',
+		comments INTEGER DEFAULT 0,
+		url TEXT NOT NULL DEFAULT '',
+		draft BOOLEAN DEFAULT 0,
+		created_at DATETIME,
+		updated_at DATETIME,
+		closed_at DATETIME,
+		merged_at DATETIME,
+		synced_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		UNIQUE(number, repository_full_name)
+	);
+	`
+	_, err := db.conn.Exec(query)
+	return err
+}
+
+func (db *DB) SaveIssue(issue *Issue) error {
+	labels, _ := json.Marshal(nonNil(issue.Labels))
+	assignees, _ := json.Marshal(nonNil(issue.Assignees))
+	query := `
+	INSERT OR REPLACE INTO issues
+	(repository_full_name, number, title, state, author, labels, assignees, comments, url, created_at, updated_at, closed_at, synced_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	_, err := db.conn.Exec(query,
+		issue.RepositoryFullName, issue.Number, issue.Title, issue.State, issue.Author,
+		string(labels), string(assignees), issue.Comments, issue.URL,
+		issue.CreatedAt, issue.UpdatedAt, issue.ClosedAt, time.Now())
+	return err
+}
+
+func (db *DB) SavePullRequest(pr *PullRequest) error {
+	labels, _ := json.Marshal(nonNil(pr.Labels))
+	assignees, _ := json.Marshal(nonNil(pr.Assignees))
+	query := `
+	INSERT OR REPLACE INTO pull_requests
+	(repository_full_name, number, title, state, author, labels, assignees, comments, url, draft, created_at, updated_at, closed_at, merged_at, synced_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	_, err := db.conn.Exec(query,
+		pr.RepositoryFullName, pr.Number, pr.Title, pr.State, pr.Author,
+		string(labels), string(assignees), pr.Comments, pr.URL, pr.Draft,
+		pr.CreatedAt, pr.UpdatedAt, pr.ClosedAt, pr.MergedAt, time.Now())
+	return err
+}
+
+// GetLatestIssueUpdate returns the newest updated_at over the stored issues
+// and pull requests of a repository, or the zero time if none is stored.
+func (db *DB) GetLatestIssueUpdate(repositoryFullName string) (time.Time, error) {
+	query := `
+	SELECT updated_at FROM (
+		SELECT updated_at FROM issues WHERE repository_full_name = ?
+		UNION ALL
+		SELECT updated_at FROM pull_requests WHERE repository_full_name = ?
+	) ORDER BY updated_at DESC LIMIT 1
+	`
+	var latest string
+	err := db.conn.QueryRow(query, repositoryFullName, repositoryFullName).Scan(&latest)
+	if err == sql.ErrNoRows {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	// Depending on the column type the union keeps, the date comes back as
+	// driver text or as RFC 3339
+	return parseTimestamp(latest)
+}
+
+// where builds the WHERE clause of a filter. merged is only valid for pull
+// requests.
+func (f IssueFilter) where() (string, []any) {
+	conditions := []string{"1 = 1"}
+	var args []any
+	if f.Repository != "" {
+		conditions = append(conditions, "repository_full_name = ?")
+		args = append(args, f.Repository)
+	}
+	switch f.State {
+	case "":
+	case "merged":
+		conditions = append(conditions, "merged_at IS NOT NULL")
+	default:
+		conditions = append(conditions, "state = ?")
+		args = append(args, f.State)
+	}
+	if f.Label != "" {
+		conditions = append(conditions, "EXISTS (SELECT 1 FROM json_each(labels) WHERE value = ?)")
+		args = append(args, f.Label)
+	}
+	if f.Author != "" {
+		conditions = append(conditions, "author = ?")
+		args = append(args, f.Author)
+	}
+	return strings.Join(conditions, " AND "), args
+}
+
+const issueColumns = `id, repository_full_name, number, title, state, author, labels, assignees, comments, url, created_at, updated_at, closed_at, synced_at`
+
+// GetIssues returns the issues matching filter, most recently updated first,
+// and the total number of matches.
+func (db *DB) GetIssues(filter IssueFilter) ([]*Issue, int, error) {
+	if filter.State == "merged" {
+		return nil, 0, fmt.Errorf("invalid issue state %q", filter.State)
+	}
+	where, args := filter.where()
+
+	var total int
+	if err := db.conn.QueryRow(`SELECT COUNT(*) FROM issues WHERE `+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := `SELECT ` + issueColumns + ` FROM issues WHERE ` + where + ` ORDER BY updated_at DESC LIMIT ? OFFSET ?`
+	rows, err := db.conn.Query(query, append(args, filter.Limit, filter.Offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var issues []*Issue
+	for rows.Next() {
+		issue := &Issue{}
+		var labels, assignees string
+		var closedAt sql.NullTime
+		err := rows.Scan(&issue.ID, &issue.RepositoryFullName, &issue.Number, &issue.Title, &issue.State,
+			&issue.Author, &labels, &assignees, &issue.Comments, &issue.URL,
+			&issue.CreatedAt, &issue.UpdatedAt, &closedAt, &issue.SyncedAt)
+		if err != nil {
+			return nil, 0, err
+		}
+		json.Unmarshal([]byte(labels), &issue.Labels)
+		json.Unmarshal([]byte(assignees), &issue.Assignees)
+		if closedAt.Valid {
+			issue.ClosedAt = &closedAt.Time
+		}
+		issues = append(issues, issue)
+	}
+
+	return issues, total, nil
+}
+
+// GetPullRequests returns the pull requests matching filter, most recently
+// updated first, and the total number of matches.
+func (db *DB) GetPullRequests(filter IssueFilter) ([]*PullRequest, int, error) {
+	where, args := filter.where()
+
+	var total int
+	if err := db.conn.QueryRow(`SELECT COUNT(*) FROM pull_requests WHERE `+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := `SELECT ` + issueColumns + `, draft, merged_at FROM pull_requests WHERE ` + where + ` ORDER BY updated_at DESC LIMIT ? OFFSET ?`
+	rows, err := db.conn.Query(query, append(args, filter.Limit, filter.Offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var prs []*PullRequest
+	for rows.Next() {
+		pr := &PullRequest{}
+		var labels, assignees string
+		var closedAt, mergedAt sql.NullTime
+		err := rows.Scan(&pr.ID, &pr.RepositoryFullName, &pr.Number, &pr.Title, &pr.State,
+			&pr.Author, &labels, &assignees, &pr.Comments, &pr.URL,
+			&pr.CreatedAt, &pr.UpdatedAt, &closedAt, &pr.SyncedAt, &pr.Draft, &mergedAt)
+		if err != nil {
+			return nil, 0, err
+		}
+		json.Unmarshal([]byte(labels), &pr.Labels)
+		json.Unmarshal([]byte(assignees), &pr.Assignees)
+		if closedAt.Valid {
+			pr.ClosedAt = &closedAt.Time
+		}
+		if mergedAt.Valid {
+			pr.MergedAt = &mergedAt.Time
+		}
+		prs = append(prs, pr)
+	}
+
+	return prs, total, nil
+}
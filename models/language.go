@@ -0,0 +1,137 @@
+package models
+
+import (
+	"math"
+	"time"
+)
+
+// Language is the size of one language in a repository as reported by
+// GitHub's linguist.
+type Language struct {
+	RepositoryFullName string    `json:"repository_full_name" db:"repository_full_name"`
+	Name               string    `json:"name" db:"language"`
+	Bytes              int64     `json:"bytes" db:"bytes"`
+	Percentage         float64   `json:"percentage"`
+	SyncedAt           time.Time `json:"synced_at" db:"synced_at"`
+}
+
+// LanguageTotal sums a language over all repositories.
+type LanguageTotal struct {
+	Name         string  `json:"name"`
+	Bytes        int64   `json:"bytes"`
+	Percentage   float64 `json:"percentage"`
+	Repositories int     `json:"repositories"`
+}
+
+func (db *DB) createLanguageTable() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS repository_languages (
+		repository_full_name TEXT NOT NULL,
+		language TEXT NOT NULL,
+		bytes INTEGER NOT NULL DEFAULT 0,
+		synced_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (repository_full_name, language)
+	);
+	`
+	_, err := db.conn.Exec(query)
+	return err
+}
+
+// ReplaceLanguages replaces the stored languages of a repository.
+func (db *DB) ReplaceLanguages(repositoryFullName string, languages []*Language) error {
+	tx, err := db.conn.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM repository_languages WHERE repository_full_name = ?`, repositoryFullName); err != nil {
+		return err
+	}
+
+	query := `INSERT INTO repository_languages (repository_full_name, language, bytes, synced_at) VALUES (?, ?, ?, ?)`
+	now := time.Now()
+	for _, l := range languages {
+		if _, err := tx.Exec(query, repositoryFullName, l.Name, l.Bytes, now); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetLanguages returns the languages of a repository, largest first, with
+// their share of the repository's bytes.
+func (db *DB) GetLanguages(repositoryFullName string) ([]*Language, error) {
+	query := `SELECT repository_full_name, language, bytes, synced_at
+			  FROM repository_languages
+			  WHERE repository_full_name = ?
+			  ORDER BY bytes DESC, language`
+	rows, err := db.conn.Query(query, repositoryFullName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var languages []*Language
+	var total int64
+	for rows.Next() {
+		l := &Language{}
+		if err := rows.Scan(&l.RepositoryFullName, &l.Name, &l.Bytes, &l.SyncedAt); err != nil {
+			return nil, err
+		}
+		total += l.Bytes
+		languages = append(languages, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, l := range languages {
+		l.Percentage = percentage(l.Bytes, total)
+	}
+	return languages, nil
+}
+
+// GetLanguageTotals sums the languages of all repositories, or of the
+// repositories of owner if set, largest first. Owners are qualified with their
+// host as in GetContributorLeaderboard.
+func (db *DB) GetLanguageTotals(owner string) ([]*LanguageTotal, error) {
+	query := `SELECT language, SUM(bytes), COUNT(*)
+			  FROM repository_languages
+			  WHERE ? = '' OR repository_full_name LIKE ? ESCAPE '\'
+			  GROUP BY language
+			  ORDER BY SUM(bytes) DESC, language`
+	rows, err := db.conn.Query(query, owner, escapeLike(owner)+"/%")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var totals []*LanguageTotal
+	var total int64
+	for rows.Next() {
+		t := &LanguageTotal{}
+		if err := rows.Scan(&t.Name, &t.Bytes, &t.Repositories); err != nil {
+			return nil, err
+		}
+		total += t.Bytes
+		totals = append(totals, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, t := range totals {
+		t.Percentage = percentage(t.Bytes, total)
+	}
+	return totals, nil
+}
+
+// percentage returns part as a percentage of total, rounded to two decimals.
+func percentage(part, total int64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return math.Round(float64(part)*10000/float64(total)) / 100
+}
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetLanguageTotals(t *testing.T) {
+	db := newTestDB(t)
+
+	repos := map[string][]*Language{
+		"o/a":                 {{Name: "Go", Bytes: 600}, {Name: "Shell", Bytes: 100}},
+		"other/b":             {{Name: "Rust", Bytes: 1000}},
+		"ghe.example.com/o/c": {{Name: "Go", Bytes: 300}},
+		"gitlab.com/g/sub/d":  {{Name: "Python", Bytes: 200}},
+	}
+	for fullName, languages := range repos {
+		if err := db.ReplaceLanguages(fullName, languages); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	type total struct {
+		name         string
+		bytes        int64
+		repositories int
+	}
+	tests := []struct {
+		owner string
+		want  []total
+	}{
+		{"", []total{{"Rust", 1000, 1}, {"Go", 900, 2}, {"Python", 200, 1}, {"Shell", 100, 1}}},
+		{"o", []total{{"Go", 600, 1}, {"Shell", 100, 1}}},
+		{"ghe.example.com/o", []total{{"Go", 300, 1}}},
+		{"gitlab.com/g", []total{{"Python", 200, 1}}},
+		{"gitlab.com", []total{{"Python", 200, 1}}},
+		{"unknown", nil},
+	}
+	for _, tt := range tests {
+		totals, err := db.GetLanguageTotals(tt.owner)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []total
+		for _, l := range totals {
+			got = append(got, total{l.Name, l.Bytes, l.Repositories})
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("GetLanguageTotals(%q) = %v, want %v", tt.owner, got, tt.want)
+		}
+	}
+
+	totals, err := db.GetLanguageTotals("o")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if totals[0].Percentage != 85.71 || totals[1].Percentage != 14.29 {
+		t.Errorf("percentages = %v, %v, want 85.71, 14.29", totals[0].Percentage, totals[1].Percentage)
+	}
+}
@@ -0,0 +1,99 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// MetricsSnapshot holds the counters of a repository at one sync.
+type MetricsSnapshot struct {
+	RepositoryFullName string    `json:"repository_full_name" db:"repository_full_name"`
+	Stars              int       `json:"stars" db:"stars"`
+	Forks              int       `json:"forks" db:"forks"`
+	Watchers           int       `json:"watchers" db:"watchers"`
+	OpenIssues         int       `json:"open_issues_count" db:"open_issues_count"`
+	RecordedAt         time.Time `json:"recorded_at" db:"recorded_at"`
+}
+
+// MetricsPoint is the last snapshot within a bucket of a metrics series.
+type MetricsPoint struct {
+	Date       string `json:"date"` // first day of the bucket, YYYY-MM-DD
+	Stars      int    `json:"stars"`
+	Forks      int    `json:"forks"`
+	Watchers   int    `json:"watchers"`
+	OpenIssues int    `json:"open_issues_count"`
+}
+
+// metricsBuckets maps the accepted intervals to the SQLite expression that
+// yields the first day of a snapshot's bucket. Weeks start on Monday.
+var metricsBuckets = map[string]string{
+	"day":  "date(recorded_at)",
+	"week": "date(recorded_at, 'weekday 0', '-6 days')",
+}
+
+func (db *DB) createMetricsTable() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS repository_metrics (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		repository_full_name TEXT NOT NULL,
+		stars INTEGER DEFAULT 0,
+		forks INTEGER DEFAULT 0,
+		watchers INTEGER DEFAULT 0,
+		open_issues_count INTEGER DEFAULT 0,
+		recorded_at DATETIME NOT NULL
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_repository_metrics_recorded
+	ON repository_metrics (repository_full_name, recorded_at);
+	`
+	_, err := db.conn.Exec(query)
+	return err
+}
+
+// RecordMetricsSnapshot appends the current counters of a stored repository
+// to its metrics series.
+func (db *DB) RecordMetricsSnapshot(fullName string) error {
+	query := `
+	INSERT INTO repository_metrics
+	(repository_full_name, stars, forks, watchers, open_issues_count, recorded_at)
+	SELECT full_name, stars, forks, watchers, open_issues_count, ?
+	FROM repositories WHERE full_name = ?
+	`
+	_, err := db.conn.Exec(query, time.Now().UTC(), fullName)
+	return err
+}
+
+// GetMetricsSeries returns the metrics of a repository recorded in [from, to),
+// one point per day or week holding the last snapshot of that bucket.
+func (db *DB) GetMetricsSeries(fullName string, from, to time.Time, interval string) ([]*MetricsPoint, error) {
+	bucket, ok := metricsBuckets[interval]
+	if !ok {
+		return nil, fmt.Errorf("invalid interval %q", interval)
+	}
+
+	// With MAX, SQLite takes the bare columns from the row holding the
+	// maximum, which is the newest snapshot of each bucket
+	query := `SELECT ` + bucket + ` AS bucket, MAX(recorded_at), stars, forks, watchers, open_issues_count
+			  FROM repository_metrics
+			  WHERE repository_full_name = ? AND recorded_at >= ? AND recorded_at < ?
+			  GROUP BY bucket
+			  ORDER BY bucket`
+	rows, err := db.conn.Query(query, fullName, from.UTC(), to.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var points []*MetricsPoint
+	for rows.Next() {
+		p := &MetricsPoint{}
+		var recordedAt string
+		err := rows.Scan(&p.Date, &recordedAt, &p.Stars, &p.Forks, &p.Watchers, &p.OpenIssues)
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, p)
+	}
+
+	return points, nil
+}
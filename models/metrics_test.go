@@ -0,0 +1,134 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGetMetricsSeries(t *testing.T) {
+	db := newTestDB(t)
+
+	// 2024-01-01 is a Monday
+	snapshots := []struct {
+		at    time.Time
+		stars int
+	}{
+		{time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC), 10},
+		{time.Date(2024, 1, 1, 20, 0, 0, 0, time.UTC), 12},
+		{time.Date(2024, 1, 3, 12, 0, 0, 0, time.UTC), 15},
+		{time.Date(2024, 1, 7, 23, 59, 0, 0, time.UTC), 18}, // Sunday
+		{time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC), 20},
+		{time.Date(2024, 1, 16, 9, 0, 0, 0, time.UTC), 25},
+	}
+	for _, s := range snapshots {
+		_, err := db.conn.Exec(`INSERT INTO repository_metrics
+			(repository_full_name, stars, forks, watchers, open_issues_count, recorded_at)
+			VALUES (?, ?, 0, 0, 0, ?)`, "o/r", s.stars, s.at)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Another repository must not show up
+	db.conn.Exec(`INSERT INTO repository_metrics (repository_full_name, stars, recorded_at) VALUES (?, ?, ?)`,
+		"o/other", 99, snapshots[0].at)
+
+	type point struct {
+		date  string
+		stars int
+	}
+	tests := []struct {
+		name     string
+		from, to time.Time
+		interval string
+		want     []point
+		wantErr  bool
+	}{
+		{
+			name:     "daily",
+			from:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			interval: "day",
+			want:     []point{{"2024-01-01", 12}, {"2024-01-03", 15}, {"2024-01-07", 18}, {"2024-01-08", 20}, {"2024-01-16", 25}},
+		},
+		{
+			name:     "weekly, weeks start on Monday",
+			from:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			interval: "week",
+			want:     []point{{"2024-01-01", 18}, {"2024-01-08", 20}, {"2024-01-15", 25}},
+		},
+		{
+			name:     "to is exclusive",
+			from:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC),
+			interval: "week",
+			want:     []point{{"2024-01-01", 18}},
+		},
+		{
+			name:     "empty range",
+			from:     time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+			interval: "day",
+		},
+		{
+			name:     "invalid interval",
+			from:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			interval: "month",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		points, err := db.GetMetricsSeries("o/r", tt.from, tt.to, tt.interval)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if len(points) != len(tt.want) {
+			t.Errorf("%s: got %d points, want %d", tt.name, len(points), len(tt.want))
+			continue
+		}
+		for i, p := range points {
+			if p.Date != tt.want[i].date || p.Stars != tt.want[i].stars {
+				t.Errorf("%s: point %d = %s/%d, want %s/%d", tt.name, i, p.Date, p.Stars, tt.want[i].date, tt.want[i].stars)
+			}
+		}
+	}
+}
+
+func TestRecordMetricsSnapshot(t *testing.T) {
+	db := newTestDB(t)
+
+	repo := &Repository{Name: "r", FullName: "o/r", Stars: 10, Forks: 2, Watchers: 3, OpenIssues: 4}
+	if err := db.SaveRepository(repo); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.RecordMetricsSnapshot("o/r"); err != nil {
+		t.Fatal(err)
+	}
+	repo.Stars = 11
+	if err := db.SaveRepository(repo); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.RecordMetricsSnapshot("o/r"); err != nil {
+		t.Fatal(err)
+	}
+	// Unknown repositories record nothing
+	if err := db.RecordMetricsSnapshot("o/unknown"); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	points, err := db.GetMetricsSeries("o/r", now.Add(-time.Hour), now.Add(time.Hour), "day")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(points) != 1 {
+		t.Fatalf("got %d points, want 1", len(points))
+	}
+	p := points[0]
+	if p.Stars != 11 || p.Forks != 2 || p.Watchers != 3 || p.OpenIssues != 4 {
+		t.Errorf("point = %+v, want the last snapshot of the day", p)
+	}
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"fmt"
+)
+
+// addColumn adds a column to an existing table unless it is already present,
+// so that databases created by older versions keep working.
+func (db *DB) addColumn(table, column, definition string) error {
+	exists, err := db.hasColumn(table, column)
+	if err != nil || exists {
+		return err
+	}
+
+	_, err = db.conn.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition))
+	if err != nil {
+		return fmt.Errorf("failed to add column %s.%s: %w", table, column, err)
+	}
+	return nil
+}
+
+// hasColumn reports whether a table has a column.
+func (db *DB) hasColumn(table, column string) (bool, error) {
+	rows, err := db.conn.Query(fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			cid        int
+			name, typ  string
+			notNull    bool
+			defaultVal any
+			primaryKey int
+		)
+		if err := rows.Scan(&cid, &name, &typ, &notNull, &defaultVal, &primaryKey); err != nil {
+			return false, err
+		}
+		if name == column {
+			return true, nil
+		}
+	}
+	return false, rows.Err()
+}
@@ -0,0 +1,74 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Readme is the cached README of a repository. SHA is the git blob SHA of the
+// file and decides whether the cache is stale.
+type Readme struct {
+	RepositoryFullName string    `json:"repository_full_name" db:"repository_full_name"`
+	Path               string    `json:"path" db:"path"`
+	SHA                string    `json:"sha" db:"sha"`
+	Content            string    `json:"content" db:"content"` // raw markdown
+	HTML               string    `json:"html" db:"html"`
+	URL                string    `json:"url" db:"url"`
+	SyncedAt           time.Time `json:"synced_at" db:"synced_at"`
+}
+
+func (db *DB) createReadmeTable() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS readmes (
+		repository_full_name TEXT PRIMARY KEY,
+		path TEXT NOT NULL,
+		sha TEXT NOT NULL,
+		content TEXT NOT NULL,
+		html TEXT NOT NULL,
+		url TEXT NOT NULL DEFAULT '',
+		synced_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	);
+	`
+	_, err := db.conn.Exec(query)
+	return err
+}
+
+func (db *DB) SaveReadme(readme *Readme) error {
+	query := `
+	INSERT OR REPLACE INTO readmes
+	(repository_full_name, path, sha, content, html, url, synced_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+	_, err := db.conn.Exec(query,
+		readme.RepositoryFullName, readme.Path, readme.SHA, readme.Content,
+		readme.HTML, readme.URL, time.Now())
+	return err
+}
+
+// TouchReadme marks the cached README of a repository as synced.
+func (db *DB) TouchReadme(repositoryFullName string) error {
+	_, err := db.conn.Exec(`UPDATE readmes SET synced_at = ? WHERE repository_full_name = ?`, time.Now(), repositoryFullName)
+	return err
+}
+
+func (db *DB) DeleteReadme(repositoryFullName string) error {
+	_, err := db.conn.Exec(`DELETE FROM readmes WHERE repository_full_name = ?`, repositoryFullName)
+	return err
+}
+
+// GetReadme returns the cached README of a repository, or nil if none is
+// stored.
+func (db *DB) GetReadme(repositoryFullName string) (*Readme, error) {
+	query := `SELECT repository_full_name, path, sha, content, html, url, synced_at
+			  FROM readmes WHERE repository_full_name = ?`
+	readme := &Readme{}
+	err := db.conn.QueryRow(query, repositoryFullName).Scan(&readme.RepositoryFullName, &readme.Path,
+		&readme.SHA, &readme.Content, &readme.HTML, &readme.URL, &readme.SyncedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return readme, nil
+}
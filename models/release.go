@@ -0,0 +1,212 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+type Release struct {
+	ID                 int        `json:"id" db:"id"`
+	ReleaseID          int64      `json:"release_id" db:"release_id"` // GitHub release ID
+	RepositoryFullName string     `json:"repository_full_name" db:"repository_full_name"`
+	TagName            string     `json:"tag_name" db:"tag_name"`
+	Name               string     `json:"name" db:"name"`
+	Body               string     `json:"body" db:"body"`
+	Draft              bool       `json:"draft" db:"draft"`
+	Prerelease         bool       `json:"prerelease" db:"prerelease"`
+	Author             string     `json:"author" db:"author"`
+	URL                string     `json:"url" db:"url"`
+	CreatedAt          time.Time  `json:"created_at" db:"created_at"`
+	PublishedAt        *time.Time `json:"published_at" db:"published_at"` // nil for drafts
+	SyncedAt           time.Time  `json:"synced_at" db:"synced_at"`
+}
+
+type Tag struct {
+	ID                 int       `json:"id" db:"id"`
+	RepositoryFullName string    `json:"repository_full_name" db:"repository_full_name"`
+	Name               string    `json:"name" db:"name"`
+	CommitSHA          string    `json:"commit_sha" db:"commit_sha"`
+	SyncedAt           time.Time `json:"synced_at" db:"synced_at"`
+}
+
+func (db *DB) createReleaseTables() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS releases (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		release_id INTEGER NOT NULL,
+		repository_full_name TEXT NOT NULL,
+		tag_name TEXT NOT NULL,
+		name TEXT NOT NULL DEFAULT '',
+		body TEXT NOT NULL DEFAULT '',
+		draft BOOLEAN DEFAULT 0,
+		prerelease BOOLEAN DEFAULT 0,
+		author TEXT NOT NULL DEFAULT '',
+		url TEXT NOT NULL DEFAULT '',
+		created_at DATETIME,
+		published_at DATETIME,
+		synced_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		UNIQUE(release_id, repository_full_name)
+	);
+
+	CREATE TABLE IF NOT EXISTS tags (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		repository_full_name TEXT NOT NULL,
+		name TEXT NOT NULL,
+		commit_sha TEXT NOT NULL,
+		synced_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		UNIQUE(name, repository_full_name)
+	);
+	`
+	_, err := db.conn.Exec(query)
+	return err
+}
+
+// ReplaceReleases stores the full release list of a repository, removing
+// releases that were deleted on GitHub.
+func (db *DB) ReplaceReleases(repositoryFullName string, releases []*Release) error {
+	tx, err := db.conn.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM releases WHERE repository_full_name = ?`, repositoryFullName); err != nil {
+		return err
+	}
+
+	query := `
+	INSERT INTO releases
+	(release_id, repository_full_name, tag_name, name, body, draft, prerelease, author, url, created_at, published_at, synced_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	now := time.Now()
+	for _, release := range releases {
+		_, err := tx.Exec(query,
+			release.ReleaseID, repositoryFullName, release.TagName, release.Name, release.Body,
+			release.Draft, release.Prerelease, release.Author, release.URL,
+			release.CreatedAt, release.PublishedAt, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ReplaceTags stores the full tag list of a repository, removing tags that
+// were deleted on GitHub.
+func (db *DB) ReplaceTags(repositoryFullName string, tags []*Tag) error {
+	tx, err := db.conn.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM tags WHERE repository_full_name = ?`, repositoryFullName); err != nil {
+		return err
+	}
+
+	query := `INSERT INTO tags (repository_full_name, name, commit_sha, synced_at) VALUES (?, ?, ?, ?)`
+	now := time.Now()
+	for _, tag := range tags {
+		if _, err := tx.Exec(query, repositoryFullName, tag.Name, tag.CommitSHA, now); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+const releaseColumns = `id, release_id, repository_full_name, tag_name, name, body, draft, prerelease, author, url, created_at, published_at, synced_at`
+
+func scanRelease(scanner interface{ Scan(...any) error }) (*Release, error) {
+	release := &Release{}
+	var publishedAt sql.NullTime
+	err := scanner.Scan(&release.ID, &release.ReleaseID, &release.RepositoryFullName, &release.TagName,
+		&release.Name, &release.Body, &release.Draft, &release.Prerelease, &release.Author, &release.URL,
+		&release.CreatedAt, &publishedAt, &release.SyncedAt)
+	if err != nil {
+		return nil, err
+	}
+	if publishedAt.Valid {
+		release.PublishedAt = &publishedAt.Time
+	}
+	return release, nil
+}
+
+// GetReleases returns the releases of a repository, newest first. Drafts have
+// no publish date and are ordered by their creation date.
+func (db *DB) GetReleases(repositoryFullName string, limit, offset int) ([]*Release, error) {
+	query := `SELECT ` + releaseColumns + `
+			  FROM releases
+			  WHERE repository_full_name = ?
+			  ORDER BY COALESCE(published_at, created_at) DESC
+			  LIMIT ? OFFSET ?`
+	rows, err := db.conn.Query(query, repositoryFullName, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var releases []*Release
+	for rows.Next() {
+		release, err := scanRelease(rows)
+		if err != nil {
+			return nil, err
+		}
+		releases = append(releases, release)
+	}
+
+	return releases, nil
+}
+
+// GetLatestRelease returns the most recently published release that is
+// neither a draft nor a prerelease, or nil if there is none.
+func (db *DB) GetLatestRelease(repositoryFullName string) (*Release, error) {
+	query := `SELECT ` + releaseColumns + `
+			  FROM releases
+			  WHERE repository_full_name = ? AND draft = 0 AND prerelease = 0 AND published_at IS NOT NULL
+			  ORDER BY published_at DESC
+			  LIMIT 1`
+	release, err := scanRelease(db.conn.QueryRow(query, repositoryFullName))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return release, err
+}
+
+func (db *DB) GetReleaseCount(repositoryFullName string) (int, error) {
+	var count int
+	err := db.conn.QueryRow(`SELECT COUNT(*) FROM releases WHERE repository_full_name = ?`, repositoryFullName).Scan(&count)
+	return count, err
+}
+
+func (db *DB) GetTags(repositoryFullName string, limit, offset int) ([]*Tag, error) {
+	query := `SELECT id, repository_full_name, name, commit_sha, synced_at
+			  FROM tags
+			  WHERE repository_full_name = ?
+			  ORDER BY id
+			  LIMIT ? OFFSET ?`
+	rows, err := db.conn.Query(query, repositoryFullName, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tags []*Tag
+	for rows.Next() {
+		tag := &Tag{}
+		if err := rows.Scan(&tag.ID, &tag.RepositoryFullName, &tag.Name, &tag.CommitSHA, &tag.SyncedAt); err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+
+	return tags, nil
+}
+
+func (db *DB) GetTagCount(repositoryFullName string) (int, error) {
+	var count int
+	err := db.conn.QueryRow(`SELECT COUNT(*) FROM tags WHERE repository_full_name = ?`, repositoryFullName).Scan(&count)
+	return count, err
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -9,17 +10,29 @@ import (
 )
 
 type Repository struct {
-	ID          int       `json:"id" db:"id"`
-	Name        string    `json:"name" db:"name"`
-	FullName    string    `json:"full_name" db:"full_name"`
-	Description string    `json:"description" db:"description"`
-	URL         string    `json:"url" db:"url"`
-	Language    string    `json:"language" db:"language"`
-	Stars       int       `json:"stars" db:"stars"`
-	Forks       int       `json:"forks" db:"forks"`
-	CreatedAt   time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
-	SyncedAt    time.Time `json:"synced_at" db:"synced_at"`
+	ID            int        `json:"id" db:"id"`
+	Name          string     `json:"name" db:"name"`
+	FullName      string     `json:"full_name" db:"full_name"`
+	Description   string     `json:"description" db:"description"`
+	URL           string     `json:"url" db:"url"`
+	Homepage      string     `json:"homepage" db:"homepage"`
+	Language      string     `json:"language" db:"language"`
+	Topics        []string   `json:"topics" db:"topics"`
+	License       string     `json:"license" db:"license"` // SPDX ID
+	DefaultBranch string     `json:"default_branch" db:"default_branch"`
+	Archived      bool       `json:"archived" db:"archived"`
+	Disabled      bool       `json:"disabled" db:"disabled"`
+	Fork          bool       `json:"fork" db:"fork"`
+	Private       bool       `json:"private" db:"private"`
+	Stars         int        `json:"stars" db:"stars"`
+	Forks         int        `json:"forks" db:"forks"`
+	Watchers      int        `json:"watchers" db:"watchers"`
+	OpenIssues    int        `json:"open_issues_count" db:"open_issues_count"` // includes pull requests
+	Size          int        `json:"size" db:"size"`                           // in KB
+	CreatedAt     time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at" db:"updated_at"`
+	PushedAt      *time.Time `json:"pushed_at" db:"pushed_at"`
+	SyncedAt      time.Time  `json:"synced_at" db:"synced_at"`
 }
 
 type Commit struct {
@@ -38,7 +51,8 @@ type DB struct {
 }
 
 func NewDB(dbPath string) (*DB, error) {
-	conn, err := sql.Open("sqlite3", dbPath)
+	// Wait for locks instead of failing when concurrent sync workers write
+	conn, err := sql.Open("sqlite3", dbPath+"?_busy_timeout=5000&_journal_mode=WAL")
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
@@ -64,7 +78,19 @@ func (db *DB) createTables() error {
 		forks INTEGER DEFAULT 0,
 		created_at DATETIME,
 		updated_at DATETIME,
-		synced_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		synced_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		homepage TEXT NOT NULL DEFAULT '',
+		topics TEXT NOT NULL DEFAULT '[]',
+		license TEXT NOT NULL DEFAULT '',
+		default_branch TEXT NOT NULL DEFAULT '',
+		archived BOOLEAN DEFAULT 0,
+		disabled BOOLEAN DEFAULT 0,
+		fork BOOLEAN DEFAULT 0,
+		private BOOLEAN DEFAULT 0,
+		watchers INTEGER DEFAULT 0,
+		open_issues_count INTEGER DEFAULT 0,
+		size INTEGER DEFAULT 0,
+		pushed_at DATETIME
 	);
 	`
 	_, err := db.conn.Exec(repoQuery)
@@ -87,25 +113,147 @@ func (db *DB) createTables() error {
 	);
 	`
 	_, err = db.conn.Exec(commitQuery)
+	if err != nil {
+		return err
+	}
+
+	for _, create := range []func() error{
+		db.createBackfillTable,
+		db.createHTTPCacheTable,
+		db.createSyncJobTable,
+		db.createReleaseTables,
+		db.createBranchTables,
+		db.createContributorTable,
+		db.createIssueTables,
+		db.createLanguageTable,
+		db.createMetricsTable,
+		db.createReadmeTable,
+		db.createWorkflowTables,
+		db.createCommitDetailTables,
+		db.createDiscoveryTable,
+		db.migrateRepositoryTable,
+	} {
+		if err := create(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// repositoryColumns are the extended metadata columns added after the
+// repositories table was introduced, with their definitions.
+var repositoryColumns = []struct{ name, definition string }{
+	{"homepage", "TEXT NOT NULL DEFAULT ''"},
+	{"topics", "TEXT NOT NULL DEFAULT '[]'"},
+	{"license", "TEXT NOT NULL DEFAULT ''"},
+	{"default_branch", "TEXT NOT NULL DEFAULT ''"},
+	{"archived", "BOOLEAN DEFAULT 0"},
+	{"disabled", "BOOLEAN DEFAULT 0"},
+	{"fork", "BOOLEAN DEFAULT 0"},
+	{"private", "BOOLEAN DEFAULT 0"},
+	{"watchers", "INTEGER DEFAULT 0"},
+	{"open_issues_count", "INTEGER DEFAULT 0"},
+	{"size", "INTEGER DEFAULT 0"},
+	{"pushed_at", "DATETIME"},
+}
+
+// migrateRepositoryTable adds the extended metadata columns to databases
+// created by older versions.
+func (db *DB) migrateRepositoryTable() error {
+	migrated, err := db.hasColumn("repositories", "default_branch")
+	if err != nil {
+		return err
+	}
+	if migrated {
+		return nil
+	}
+
+	for _, column := range repositoryColumns {
+		if err := db.addColumn("repositories", column.name, column.definition); err != nil {
+			return err
+		}
+	}
+
+	// Stored validators would answer the next sync with 304 and leave the new
+	// columns empty, so fetch everything once
+	_, err = db.conn.Exec(`DELETE FROM http_cache`)
 	return err
 }
 
 func (db *DB) SaveRepository(repo *Repository) error {
+	topics, _ := json.Marshal(nonNil(repo.Topics))
 	query := `
 	INSERT OR REPLACE INTO repositories 
-	(name, full_name, description, url, language, stars, forks, created_at, updated_at, synced_at)
-	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	(name, full_name, description, url, homepage, language, topics, license, default_branch,
+	 archived, disabled, fork, private, stars, forks, watchers, open_issues_count, size,
+	 created_at, updated_at, pushed_at, synced_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 	_, err := db.conn.Exec(query,
-		repo.Name, repo.FullName, repo.Description, repo.URL,
-		repo.Language, repo.Stars, repo.Forks,
-		repo.CreatedAt, repo.UpdatedAt, time.Now())
+		repo.Name, repo.FullName, repo.Description, repo.URL, repo.Homepage,
+		repo.Language, string(topics), repo.License, repo.DefaultBranch,
+		repo.Archived, repo.Disabled, repo.Fork, repo.Private,
+		repo.Stars, repo.Forks, repo.Watchers, repo.OpenIssues, repo.Size,
+		repo.CreatedAt, repo.UpdatedAt, repo.PushedAt, time.Now())
 	return err
 }
 
+const repositorySelect = `SELECT id, name, full_name, description, url, homepage, language, topics, license, default_branch,
+	archived, disabled, fork, private, stars, forks, watchers, open_issues_count, size,
+	created_at, updated_at, pushed_at, synced_at FROM repositories`
+
+// scanRepository scans a row selected with repositorySelect.
+func scanRepository(row interface{ Scan(...any) error }) (*Repository, error) {
+	repo := &Repository{}
+	var topics string
+	var pushedAt sql.NullTime
+	err := row.Scan(&repo.ID, &repo.Name, &repo.FullName, &repo.Description,
+		&repo.URL, &repo.Homepage, &repo.Language, &topics, &repo.License, &repo.DefaultBranch,
+		&repo.Archived, &repo.Disabled, &repo.Fork, &repo.Private,
+		&repo.Stars, &repo.Forks, &repo.Watchers, &repo.OpenIssues, &repo.Size,
+		&repo.CreatedAt, &repo.UpdatedAt, &pushedAt, &repo.SyncedAt)
+	if err != nil {
+		return nil, err
+	}
+	json.Unmarshal([]byte(topics), &repo.Topics)
+	if pushedAt.Valid {
+		repo.PushedAt = &pushedAt.Time
+	}
+	return repo, nil
+}
+
+// ResolveRepositoryName returns the name under which a repository is stored,
+// which may differ in case from fullName, e.g. when taken from a configured
+// URL. fullName is returned unchanged if the repository is not stored.
+func (db *DB) ResolveRepositoryName(fullName string) (string, error) {
+	var stored string
+	err := db.conn.QueryRow(`SELECT full_name FROM repositories WHERE full_name = ? COLLATE NOCASE
+							 ORDER BY full_name = ? DESC LIMIT 1`, fullName, fullName).Scan(&stored)
+	if err == sql.ErrNoRows {
+		return fullName, nil
+	}
+	return stored, err
+}
+
+func (db *DB) HasRepository(fullName string) (bool, error) {
+	var count int
+	err := db.conn.QueryRow(`SELECT COUNT(*) FROM repositories WHERE full_name = ?`, fullName).Scan(&count)
+	return count > 0, err
+}
+
+// TouchRepository marks a repository as synced without changing its data.
+// It reports whether the repository exists.
+func (db *DB) TouchRepository(fullName string) (bool, error) {
+	result, err := db.conn.Exec(`UPDATE repositories SET synced_at = ? WHERE full_name = ?`, time.Now(), fullName)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
 func (db *DB) GetRepositories() ([]*Repository, error) {
-	query := `SELECT id, name, full_name, description, url, language, stars, forks, created_at, updated_at, synced_at FROM repositories ORDER BY stars DESC`
-	rows, err := db.conn.Query(query)
+	rows, err := db.conn.Query(repositorySelect + ` ORDER BY stars DESC`)
 	if err != nil {
 		return nil, err
 	}
@@ -113,10 +261,7 @@ func (db *DB) GetRepositories() ([]*Repository, error) {
 
 	var repositories []*Repository
 	for rows.Next() {
-		repo := &Repository{}
-		err := rows.Scan(&repo.ID, &repo.Name, &repo.FullName, &repo.Description,
-			&repo.URL, &repo.Language, &repo.Stars, &repo.Forks,
-			&repo.CreatedAt, &repo.UpdatedAt, &repo.SyncedAt)
+		repo, err := scanRepository(rows)
 		if err != nil {
 			return nil, err
 		}
@@ -127,23 +272,22 @@ func (db *DB) GetRepositories() ([]*Repository, error) {
 }
 
 func (db *DB) GetRepositoryByName(fullName string) (*Repository, error) {
-	query := `SELECT id, name, full_name, description, url, language, stars, forks, created_at, updated_at, synced_at FROM repositories WHERE full_name = ?`
-	repo := &Repository{}
-	err := db.conn.QueryRow(query, fullName).Scan(
-		&repo.ID, &repo.Name, &repo.FullName, &repo.Description,
-		&repo.URL, &repo.Language, &repo.Stars, &repo.Forks,
-		&repo.CreatedAt, &repo.UpdatedAt, &repo.SyncedAt)
-	if err != nil {
-		return nil, err
-	}
-	return repo, nil
+	return scanRepository(db.conn.QueryRow(repositorySelect+` WHERE full_name = ?`, fullName))
 }
 
+// SaveCommit stores a commit. Details of a commit that is already stored are
+// kept.
 func (db *DB) SaveCommit(commit *Commit) error {
 	query := `
-	INSERT OR REPLACE INTO commits 
+	INSERT INTO commits 
 	(sha, message, author_name, author_email, commit_date, repository_full_name, synced_at)
 	VALUES (?, ?, ?, ?, ?, ?, ?)
+	ON CONFLICT(sha, repository_full_name) DO UPDATE SET
+		message = excluded.message,
+		author_name = excluded.author_name,
+		author_email = excluded.author_email,
+		commit_date = excluded.commit_date,
+		synced_at = excluded.synced_at
 	`
 	_, err := db.conn.Exec(query,
 		commit.SHA, commit.Message, commit.AuthorName, commit.AuthorEmail,
@@ -184,6 +328,25 @@ func (db *DB) GetCommitCount(repositoryFullName string) (int, error) {
 	return count, err
 }
 
+// GetLatestCommitDate returns the date of the newest stored commit of a
+// repository, or the zero time if none is stored.
+func (db *DB) GetLatestCommitDate(repositoryFullName string) (time.Time, error) {
+	query := `SELECT commit_date FROM commits WHERE repository_full_name = ? ORDER BY commit_date DESC LIMIT 1`
+	var latest time.Time
+	err := db.conn.QueryRow(query, repositoryFullName).Scan(&latest)
+	if err == sql.ErrNoRows {
+		return time.Time{}, nil
+	}
+	return latest, err
+}
+
+func (db *DB) HasCommit(sha, repositoryFullName string) (bool, error) {
+	query := `SELECT COUNT(*) FROM commits WHERE sha = ? AND repository_full_name = ?`
+	var count int
+	err := db.conn.QueryRow(query, sha, repositoryFullName).Scan(&count)
+	return count > 0, err
+}
+
 func (db *DB) Close() error {
 	return db.conn.Close()
-}
\ No newline at end of file
+}
@@ -0,0 +1,158 @@
+package models
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+)
+
+// SyncJob records a single sync run: who triggered it, which repositories it
+// covered and how it ended.
+type SyncJob struct {
+	ID           int64      `json:"id" db:"id"`
+	Kind         string     `json:"kind" db:"kind"`              // repositories, commits or backfill
+	Trigger      string     `json:"trigger" db:"trigger_source"` // http, grpc or scheduler
+	Status       string     `json:"status" db:"status"`          // running, success, partial, failed or canceled
+	Repositories []string   `json:"repositories" db:"repositories"`
+	Errors       []string   `json:"errors" db:"errors"`
+	Succeeded    int        `json:"succeeded" db:"succeeded"`
+	Failed       int        `json:"failed" db:"failed"`
+	Added        int        `json:"added" db:"added"`
+	Updated      int        `json:"updated" db:"updated"`
+	Total        int        `json:"total" db:"total"`
+	Done         int        `json:"done" db:"done"`
+	Current      []string   `json:"current_repositories" db:"-"` // only known while running
+	StartedAt    time.Time  `json:"started_at" db:"started_at"`
+	FinishedAt   *time.Time `json:"finished_at" db:"finished_at"`
+}
+
+func (db *DB) createSyncJobTable() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS sync_jobs (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		kind TEXT NOT NULL,
+		trigger_source TEXT NOT NULL,
+		status TEXT NOT NULL,
+		repositories TEXT NOT NULL DEFAULT '[]',
+		errors TEXT NOT NULL DEFAULT '[]',
+		succeeded INTEGER DEFAULT 0,
+		failed INTEGER DEFAULT 0,
+		added INTEGER DEFAULT 0,
+		updated INTEGER DEFAULT 0,
+		total INTEGER DEFAULT 0,
+		done INTEGER DEFAULT 0,
+		started_at DATETIME NOT NULL,
+		finished_at DATETIME
+	);
+	`
+	_, err := db.conn.Exec(query)
+	return err
+}
+
+// CreateSyncJob inserts a job and sets its ID.
+func (db *DB) CreateSyncJob(job *SyncJob) error {
+	repositories, _ := json.Marshal(nonNil(job.Repositories))
+	errors, _ := json.Marshal(nonNil(job.Errors))
+	query := `
+	INSERT INTO sync_jobs
+	(kind, trigger_source, status, repositories, errors, total, started_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+	result, err := db.conn.Exec(query,
+		job.Kind, job.Trigger, job.Status, string(repositories), string(errors), job.Total, job.StartedAt)
+	if err != nil {
+		return err
+	}
+	job.ID, err = result.LastInsertId()
+	return err
+}
+
+// UpdateSyncJob stores the status, counters and errors of a job.
+func (db *DB) UpdateSyncJob(job *SyncJob) error {
+	errors, _ := json.Marshal(nonNil(job.Errors))
+	query := `
+	UPDATE sync_jobs
+	SET status = ?, errors = ?, succeeded = ?, failed = ?, added = ?, updated = ?, done = ?, finished_at = ?
+	WHERE id = ?
+	`
+	_, err := db.conn.Exec(query,
+		job.Status, string(errors), job.Succeeded, job.Failed, job.Added, job.Updated,
+		job.Done, job.FinishedAt, job.ID)
+	return err
+}
+
+// UpdateSyncJobProgress stores the number of repositories done so far.
+func (db *DB) UpdateSyncJobProgress(id int64, done int) error {
+	_, err := db.conn.Exec(`UPDATE sync_jobs SET done = ? WHERE id = ?`, done, id)
+	return err
+}
+
+// InterruptRunningSyncJobs marks jobs left running by a previous process as
+// canceled.
+func (db *DB) InterruptRunningSyncJobs() error {
+	query := `
+	UPDATE sync_jobs
+	SET status = 'canceled', errors = '["interrupted by restart"]', finished_at = ?
+	WHERE status = 'running'
+	`
+	_, err := db.conn.Exec(query, time.Now())
+	return err
+}
+
+const syncJobColumns = `id, kind, trigger_source, status, repositories, errors, succeeded, failed, added, updated, total, done, started_at, finished_at`
+
+func scanSyncJob(scanner interface{ Scan(...any) error }) (*SyncJob, error) {
+	job := &SyncJob{}
+	var repositories, errors string
+	var finishedAt sql.NullTime
+	err := scanner.Scan(&job.ID, &job.Kind, &job.Trigger, &job.Status, &repositories, &errors,
+		&job.Succeeded, &job.Failed, &job.Added, &job.Updated, &job.Total, &job.Done, &job.StartedAt, &finishedAt)
+	if err != nil {
+		return nil, err
+	}
+	json.Unmarshal([]byte(repositories), &job.Repositories)
+	json.Unmarshal([]byte(errors), &job.Errors)
+	if finishedAt.Valid {
+		job.FinishedAt = &finishedAt.Time
+	}
+	return job, nil
+}
+
+func (db *DB) GetSyncJobs(limit, offset int) ([]*SyncJob, error) {
+	query := `SELECT ` + syncJobColumns + ` FROM sync_jobs ORDER BY id DESC LIMIT ? OFFSET ?`
+	rows, err := db.conn.Query(query, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var jobs []*SyncJob
+	for rows.Next() {
+		job, err := scanSyncJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+
+	return jobs, nil
+}
+
+func (db *DB) GetSyncJob(id int64) (*SyncJob, error) {
+	query := `SELECT ` + syncJobColumns + ` FROM sync_jobs WHERE id = ?`
+	return scanSyncJob(db.conn.QueryRow(query, id))
+}
+
+func (db *DB) GetSyncJobCount() (int, error) {
+	var count int
+	err := db.conn.QueryRow(`SELECT COUNT(*) FROM sync_jobs`).Scan(&count)
+	return count, err
+}
+
+// nonNil makes nil slices encode as [] instead of null.
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
@@ -0,0 +1,151 @@
+package models
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func newTestDB(t *testing.T) *DB {
+	t.Helper()
+	db, err := NewDB(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestSyncJobLifecycle(t *testing.T) {
+	db := newTestDB(t)
+
+	job := &SyncJob{
+		Kind:         "commits",
+		Trigger:      "http",
+		Status:       "running",
+		Repositories: []string{"o/a", "o/b"},
+		Total:        2,
+		StartedAt:    time.Now(),
+	}
+	if err := db.CreateSyncJob(job); err != nil {
+		t.Fatal(err)
+	}
+	if job.ID == 0 {
+		t.Fatal("CreateSyncJob did not set the ID")
+	}
+
+	stored, err := db.GetSyncJob(job.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Status != "running" || stored.FinishedAt != nil ||
+		!reflect.DeepEqual(stored.Repositories, job.Repositories) || len(stored.Errors) != 0 {
+		t.Errorf("running job = %+v", stored)
+	}
+
+	finishedAt := time.Now()
+	job.Status = "partial"
+	job.Succeeded, job.Failed, job.Added, job.Done = 1, 1, 7, 2
+	job.Errors = []string{"o/b: not found"}
+	job.FinishedAt = &finishedAt
+	if err := db.UpdateSyncJob(job); err != nil {
+		t.Fatal(err)
+	}
+	stored, err = db.GetSyncJob(job.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Status != "partial" || stored.Succeeded != 1 || stored.Failed != 1 || stored.Added != 7 ||
+		stored.Done != 2 || stored.Total != 2 || stored.FinishedAt == nil ||
+		!reflect.DeepEqual(stored.Errors, job.Errors) {
+		t.Errorf("finished job = %+v", stored)
+	}
+}
+
+func TestGetSyncJobs(t *testing.T) {
+	db := newTestDB(t)
+
+	var ids []int64
+	for _, kind := range []string{"repositories", "commits", "backfill"} {
+		job := &SyncJob{Kind: kind, Trigger: "scheduler", Status: "success", StartedAt: time.Now()}
+		if err := db.CreateSyncJob(job); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, job.ID)
+	}
+
+	jobs, err := db.GetSyncJobs(2, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 2 || jobs[0].ID != ids[2] || jobs[1].ID != ids[1] {
+		t.Fatalf("GetSyncJobs(2, 0) = %v, want the newest two jobs", jobs)
+	}
+
+	jobs, err = db.GetSyncJobs(2, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 1 || jobs[0].ID != ids[0] || jobs[0].Kind != "repositories" {
+		t.Errorf("GetSyncJobs(2, 2) = %v, want the first job", jobs)
+	}
+
+	if count, err := db.GetSyncJobCount(); err != nil || count != 3 {
+		t.Errorf("GetSyncJobCount() = %d, %v, want 3", count, err)
+	}
+	if _, err := db.GetSyncJob(ids[2] + 1); err == nil {
+		t.Error("GetSyncJob of an unknown ID succeeded")
+	}
+}
+
+func TestSyncJobProgress(t *testing.T) {
+	db := newTestDB(t)
+
+	job := &SyncJob{Kind: "commits", Trigger: "http", Status: "running", Total: 3, StartedAt: time.Now()}
+	if err := db.CreateSyncJob(job); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateSyncJobProgress(job.ID, 2); err != nil {
+		t.Fatal(err)
+	}
+	stored, err := db.GetSyncJob(job.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Total != 3 || stored.Done != 2 || stored.Status != "running" {
+		t.Errorf("job = %+v, want 2 of 3 done", stored)
+	}
+}
+
+func TestInterruptRunningSyncJobs(t *testing.T) {
+	db := newTestDB(t)
+
+	jobs := make(map[string]*SyncJob)
+	for _, status := range []string{"running", "success"} {
+		job := &SyncJob{Kind: "commits", Trigger: "scheduler", Status: status, StartedAt: time.Now()}
+		if err := db.CreateSyncJob(job); err != nil {
+			t.Fatal(err)
+		}
+		jobs[status] = job
+	}
+
+	// Jobs left running by a previous process are canceled on startup
+	if err := db.InterruptRunningSyncJobs(); err != nil {
+		t.Fatal(err)
+	}
+	interrupted, err := db.GetSyncJob(jobs["running"].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if interrupted.Status != "canceled" || interrupted.FinishedAt == nil || len(interrupted.Errors) != 1 {
+		t.Errorf("interrupted job = %+v", interrupted)
+	}
+	finished, err := db.GetSyncJob(jobs["success"].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if finished.Status != "success" {
+		t.Errorf("finished job = %+v, want it untouched", finished)
+	}
+}
@@ -0,0 +1,257 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Workflow is a GitHub Actions workflow definition. LatestRun is only set by
+// GetWorkflows.
+type Workflow struct {
+	ID                 int          `json:"id" db:"id"`
+	WorkflowID         int64        `json:"workflow_id" db:"workflow_id"` // GitHub workflow ID
+	RepositoryFullName string       `json:"repository_full_name" db:"repository_full_name"`
+	Name               string       `json:"name" db:"name"`
+	Path               string       `json:"path" db:"path"`
+	State              string       `json:"state" db:"state"` // e.g. active or disabled_manually
+	URL                string       `json:"url" db:"url"`
+	SyncedAt           time.Time    `json:"synced_at" db:"synced_at"`
+	LatestRun          *WorkflowRun `json:"latest_run"`
+}
+
+type WorkflowRun struct {
+	ID                 int        `json:"id" db:"id"`
+	RunID              int64      `json:"run_id" db:"run_id"` // GitHub run ID
+	RepositoryFullName string     `json:"repository_full_name" db:"repository_full_name"`
+	WorkflowID         int64      `json:"workflow_id" db:"workflow_id"`
+	Name               string     `json:"name" db:"name"`
+	RunNumber          int        `json:"run_number" db:"run_number"`
+	Event              string     `json:"event" db:"event"`
+	Branch             string     `json:"branch" db:"branch"`
+	HeadSHA            string     `json:"head_sha" db:"head_sha"`
+	Status             string     `json:"status" db:"status"`         // queued, in_progress, completed, ...
+	Conclusion         string     `json:"conclusion" db:"conclusion"` // empty until completed
+	URL                string     `json:"url" db:"url"`
+	CreatedAt          time.Time  `json:"created_at" db:"created_at"`
+	StartedAt          *time.Time `json:"started_at" db:"started_at"`
+	UpdatedAt          time.Time  `json:"updated_at" db:"updated_at"`
+	Duration           int        `json:"duration_seconds" db:"duration_seconds"` // 0 until completed
+	SyncedAt           time.Time  `json:"synced_at" db:"synced_at"`
+}
+
+// FailureRate summarizes the completed runs of a workflow, or of all
+// workflows of a repository when WorkflowID is 0.
+type FailureRate struct {
+	RepositoryFullName string  `json:"repository_full_name"`
+	WorkflowID         int64   `json:"workflow_id,omitempty"`
+	WorkflowName       string  `json:"workflow_name,omitempty"`
+	Runs               int     `json:"runs"`
+	Failures           int     `json:"failures"`
+	FailureRate        float64 `json:"failure_rate"` // percentage
+	AverageDuration    int     `json:"average_duration_seconds"`
+}
+
+func (db *DB) createWorkflowTables() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS workflows (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		workflow_id INTEGER NOT NULL,
+		repository_full_name TEXT NOT NULL,
+		name TEXT NOT NULL,
+		path TEXT NOT NULL DEFAULT '',
+		state TEXT NOT NULL DEFAULT '',
+		url TEXT NOT NULL DEFAULT '',
+		synced_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		UNIQUE(workflow_id, repository_full_name)
+	);
+
+	CREATE TABLE IF NOT EXISTS workflow_runs (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		run_id INTEGER NOT NULL,
+		repository_full_name TEXT NOT NULL,
+		workflow_id INTEGER NOT NULL,
+		name TEXT NOT NULL DEFAULT '',
+		run_number INTEGER DEFAULT 0,
+		event TEXT NOT NULL DEFAULT '',
+		branch TEXT NOT NULL DEFAULT '',
+		head_sha TEXT NOT NULL DEFAULT '',
+		status TEXT NOT NULL DEFAULT '',
+		conclusion TEXT NOT NULL DEFAULT '',
+		url TEXT NOT NULL DEFAULT '',
+		created_at DATETIME,
+		started_at DATETIME,
+		updated_at DATETIME,
+		duration_seconds INTEGER DEFAULT 0,
+		synced_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		UNIQUE(run_id, repository_full_name)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_workflow_runs_workflow
+	ON workflow_runs (repository_full_name, workflow_id, created_at);
+	`
+	_, err := db.conn.Exec(query)
+	return err
+}
+
+// ReplaceWorkflows stores the full workflow list of a repository, removing
+// workflows that were deleted on GitHub. Their runs are kept.
+func (db *DB) ReplaceWorkflows(repositoryFullName string, workflows []*Workflow) error {
+	tx, err := db.conn.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM workflows WHERE repository_full_name = ?`, repositoryFullName); err != nil {
+		return err
+	}
+
+	query := `
+	INSERT INTO workflows
+	(workflow_id, repository_full_name, name, path, state, url, synced_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+	now := time.Now()
+	for _, w := range workflows {
+		if _, err := tx.Exec(query, w.WorkflowID, repositoryFullName, w.Name, w.Path, w.State, w.URL, now); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// SaveWorkflowRuns stores workflow runs, updating runs that are already
+// stored, e.g. because they were still in progress.
+func (db *DB) SaveWorkflowRuns(runs []*WorkflowRun) error {
+	tx, err := db.conn.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+	INSERT OR REPLACE INTO workflow_runs
+	(run_id, repository_full_name, workflow_id, name, run_number, event, branch, head_sha, status, conclusion,
+	 url, created_at, started_at, updated_at, duration_seconds, synced_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	now := time.Now()
+	for _, r := range runs {
+		_, err := tx.Exec(query,
+			r.RunID, r.RepositoryFullName, r.WorkflowID, r.Name, r.RunNumber, r.Event, r.Branch, r.HeadSHA,
+			r.Status, r.Conclusion, r.URL, r.CreatedAt, r.StartedAt, r.UpdatedAt, r.Duration, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+const workflowRunColumns = `id, run_id, repository_full_name, workflow_id, name, run_number, event, branch, head_sha,
+	status, conclusion, url, created_at, started_at, updated_at, duration_seconds, synced_at`
+
+func scanWorkflowRun(row interface{ Scan(...any) error }) (*WorkflowRun, error) {
+	r := &WorkflowRun{}
+	var startedAt sql.NullTime
+	err := row.Scan(&r.ID, &r.RunID, &r.RepositoryFullName, &r.WorkflowID, &r.Name, &r.RunNumber,
+		&r.Event, &r.Branch, &r.HeadSHA, &r.Status, &r.Conclusion, &r.URL,
+		&r.CreatedAt, &startedAt, &r.UpdatedAt, &r.Duration, &r.SyncedAt)
+	if err != nil {
+		return nil, err
+	}
+	if startedAt.Valid {
+		r.StartedAt = &startedAt.Time
+	}
+	return r, nil
+}
+
+// GetWorkflows returns the workflows of a repository with their most recent
+// run.
+func (db *DB) GetWorkflows(repositoryFullName string) ([]*Workflow, error) {
+	query := `SELECT id, workflow_id, repository_full_name, name, path, state, url, synced_at
+			  FROM workflows
+			  WHERE repository_full_name = ?
+			  ORDER BY name`
+	rows, err := db.conn.Query(query, repositoryFullName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var workflows []*Workflow
+	byID := make(map[int64]*Workflow)
+	for rows.Next() {
+		w := &Workflow{}
+		err := rows.Scan(&w.ID, &w.WorkflowID, &w.RepositoryFullName, &w.Name, &w.Path, &w.State, &w.URL, &w.SyncedAt)
+		if err != nil {
+			return nil, err
+		}
+		workflows = append(workflows, w)
+		byID[w.WorkflowID] = w
+	}
+	rows.Close()
+
+	query = `SELECT ` + workflowRunColumns + `
+			 FROM workflow_runs r
+			 WHERE repository_full_name = ? AND run_id = (
+				SELECT run_id FROM workflow_runs
+				WHERE repository_full_name = r.repository_full_name AND workflow_id = r.workflow_id
+				ORDER BY created_at DESC, run_id DESC LIMIT 1
+			 )`
+	rows, err = db.conn.Query(query, repositoryFullName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		run, err := scanWorkflowRun(rows)
+		if err != nil {
+			return nil, err
+		}
+		if w, ok := byID[run.WorkflowID]; ok {
+			w.LatestRun = run
+		}
+	}
+
+	return workflows, nil
+}
+
+// GetFailureRates summarizes the runs completed since the given time. With a
+// repository the rates are per workflow, otherwise per repository. Only
+// successful and failed runs count, cancelled and skipped runs are ignored.
+func (db *DB) GetFailureRates(repositoryFullName string, since time.Time) ([]*FailureRate, error) {
+	workflow, group := "0, ''", "repository_full_name"
+	if repositoryFullName != "" {
+		workflow, group = "workflow_id, MAX(name)", "workflow_id"
+	}
+
+	query := `SELECT repository_full_name, ` + workflow + `, COUNT(*),
+			  SUM(conclusion IN ('failure', 'timed_out', 'startup_failure')),
+			  CAST(AVG(duration_seconds) AS INTEGER)
+			  FROM workflow_runs
+			  WHERE (? = '' OR repository_full_name = ?) AND created_at >= ?
+			  AND conclusion IN ('success', 'failure', 'timed_out', 'startup_failure')
+			  GROUP BY ` + group + `
+			  ORDER BY repository_full_name, 3` // workflow name
+	rows, err := db.conn.Query(query, repositoryFullName, repositoryFullName, since.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rates []*FailureRate
+	for rows.Next() {
+		r := &FailureRate{}
+		err := rows.Scan(&r.RepositoryFullName, &r.WorkflowID, &r.WorkflowName, &r.Runs, &r.Failures, &r.AverageDuration)
+		if err != nil {
+			return nil, err
+		}
+		r.FailureRate = percentage(int64(r.Failures), int64(r.Runs))
+		rates = append(rates, r)
+	}
+
+	return rates, nil
+}
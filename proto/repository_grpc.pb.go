@@ -19,12 +19,35 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	RepositoryService_GetRepositories_FullMethodName  = "/proto.RepositoryService/GetRepositories"
-	RepositoryService_GetRepository_FullMethodName    = "/proto.RepositoryService/GetRepository"
-	RepositoryService_SyncRepositories_FullMethodName = "/proto.RepositoryService/SyncRepositories"
-	RepositoryService_GetCommits_FullMethodName       = "/proto.RepositoryService/GetCommits"
-	RepositoryService_SyncCommits_FullMethodName      = "/proto.RepositoryService/SyncCommits"
-	RepositoryService_SyncCommitsAll_FullMethodName   = "/proto.RepositoryService/SyncCommitsAll"
+	RepositoryService_GetRepositories_FullMethodName           = "/proto.RepositoryService/GetRepositories"
+	RepositoryService_GetRepository_FullMethodName             = "/proto.RepositoryService/GetRepository"
+	RepositoryService_SyncRepositories_FullMethodName          = "/proto.RepositoryService/SyncRepositories"
+	RepositoryService_GetCommits_FullMethodName                = "/proto.RepositoryService/GetCommits"
+	RepositoryService_SyncCommits_FullMethodName               = "/proto.RepositoryService/SyncCommits"
+	RepositoryService_SyncCommitsAll_FullMethodName            = "/proto.RepositoryService/SyncCommitsAll"
+	RepositoryService_StreamSync_FullMethodName                = "/proto.RepositoryService/StreamSync"
+	RepositoryService_BackfillCommits_FullMethodName           = "/proto.RepositoryService/BackfillCommits"
+	RepositoryService_GetRateLimit_FullMethodName              = "/proto.RepositoryService/GetRateLimit"
+	RepositoryService_ListSyncJobs_FullMethodName              = "/proto.RepositoryService/ListSyncJobs"
+	RepositoryService_GetSyncJob_FullMethodName                = "/proto.RepositoryService/GetSyncJob"
+	RepositoryService_CancelSyncJob_FullMethodName             = "/proto.RepositoryService/CancelSyncJob"
+	RepositoryService_GetReleases_FullMethodName               = "/proto.RepositoryService/GetReleases"
+	RepositoryService_GetTags_FullMethodName                   = "/proto.RepositoryService/GetTags"
+	RepositoryService_GetBranches_FullMethodName               = "/proto.RepositoryService/GetBranches"
+	RepositoryService_GetContributors_FullMethodName           = "/proto.RepositoryService/GetContributors"
+	RepositoryService_GetContributorLeaderboard_FullMethodName = "/proto.RepositoryService/GetContributorLeaderboard"
+	RepositoryService_ListIssues_FullMethodName                = "/proto.RepositoryService/ListIssues"
+	RepositoryService_ListPullRequests_FullMethodName          = "/proto.RepositoryService/ListPullRequests"
+	RepositoryService_GetLanguages_FullMethodName              = "/proto.RepositoryService/GetLanguages"
+	RepositoryService_GetLanguageTotals_FullMethodName         = "/proto.RepositoryService/GetLanguageTotals"
+	RepositoryService_GetMetricsSeries_FullMethodName          = "/proto.RepositoryService/GetMetricsSeries"
+	RepositoryService_GetReadme_FullMethodName                 = "/proto.RepositoryService/GetReadme"
+	RepositoryService_GetWorkflows_FullMethodName              = "/proto.RepositoryService/GetWorkflows"
+	RepositoryService_GetActionsSummary_FullMethodName         = "/proto.RepositoryService/GetActionsSummary"
+	RepositoryService_GetCommitDetail_FullMethodName           = "/proto.RepositoryService/GetCommitDetail"
+	RepositoryService_GetChurnStats_FullMethodName             = "/proto.RepositoryService/GetChurnStats"
+	RepositoryService_GetDiscoveredRepositories_FullMethodName = "/proto.RepositoryService/GetDiscoveredRepositories"
+	RepositoryService_GetTokenHealth_FullMethodName            = "/proto.RepositoryService/GetTokenHealth"
 )
 
 // RepositoryServiceClient is the client API for RepositoryService service.
@@ -37,6 +60,29 @@ type RepositoryServiceClient interface {
 	GetCommits(ctx context.Context, in *GetCommitsRequest, opts ...grpc.CallOption) (*GetCommitsResponse, error)
 	SyncCommits(ctx context.Context, in *SyncCommitsRequest, opts ...grpc.CallOption) (*SyncCommitsResponse, error)
 	SyncCommitsAll(ctx context.Context, in *SyncCommitsAllRequest, opts ...grpc.CallOption) (*SyncCommitsResponse, error)
+	StreamSync(ctx context.Context, in *StreamSyncRequest, opts ...grpc.CallOption) (RepositoryService_StreamSyncClient, error)
+	BackfillCommits(ctx context.Context, in *BackfillCommitsRequest, opts ...grpc.CallOption) (*SyncCommitsResponse, error)
+	GetRateLimit(ctx context.Context, in *GetRateLimitRequest, opts ...grpc.CallOption) (*GetRateLimitResponse, error)
+	ListSyncJobs(ctx context.Context, in *ListSyncJobsRequest, opts ...grpc.CallOption) (*ListSyncJobsResponse, error)
+	GetSyncJob(ctx context.Context, in *GetSyncJobRequest, opts ...grpc.CallOption) (*GetSyncJobResponse, error)
+	CancelSyncJob(ctx context.Context, in *CancelSyncJobRequest, opts ...grpc.CallOption) (*CancelSyncJobResponse, error)
+	GetReleases(ctx context.Context, in *GetReleasesRequest, opts ...grpc.CallOption) (*GetReleasesResponse, error)
+	GetTags(ctx context.Context, in *GetTagsRequest, opts ...grpc.CallOption) (*GetTagsResponse, error)
+	GetBranches(ctx context.Context, in *GetBranchesRequest, opts ...grpc.CallOption) (*GetBranchesResponse, error)
+	GetContributors(ctx context.Context, in *GetContributorsRequest, opts ...grpc.CallOption) (*GetContributorsResponse, error)
+	GetContributorLeaderboard(ctx context.Context, in *GetContributorLeaderboardRequest, opts ...grpc.CallOption) (*GetContributorLeaderboardResponse, error)
+	ListIssues(ctx context.Context, in *ListIssuesRequest, opts ...grpc.CallOption) (*ListIssuesResponse, error)
+	ListPullRequests(ctx context.Context, in *ListIssuesRequest, opts ...grpc.CallOption) (*ListPullRequestsResponse, error)
+	GetLanguages(ctx context.Context, in *GetLanguagesRequest, opts ...grpc.CallOption) (*GetLanguagesResponse, error)
+	GetLanguageTotals(ctx context.Context, in *GetLanguageTotalsRequest, opts ...grpc.CallOption) (*GetLanguageTotalsResponse, error)
+	GetMetricsSeries(ctx context.Context, in *GetMetricsSeriesRequest, opts ...grpc.CallOption) (*GetMetricsSeriesResponse, error)
+	GetReadme(ctx context.Context, in *GetReadmeRequest, opts ...grpc.CallOption) (*GetReadmeResponse, error)
+	GetWorkflows(ctx context.Context, in *GetWorkflowsRequest, opts ...grpc.CallOption) (*GetWorkflowsResponse, error)
+	GetActionsSummary(ctx context.Context, in *GetActionsSummaryRequest, opts ...grpc.CallOption) (*GetActionsSummaryResponse, error)
+	GetCommitDetail(ctx context.Context, in *GetCommitDetailRequest, opts ...grpc.CallOption) (*GetCommitDetailResponse, error)
+	GetChurnStats(ctx context.Context, in *GetChurnStatsRequest, opts ...grpc.CallOption) (*GetChurnStatsResponse, error)
+	GetDiscoveredRepositories(ctx context.Context, in *GetDiscoveredRepositoriesRequest, opts ...grpc.CallOption) (*GetDiscoveredRepositoriesResponse, error)
+	GetTokenHealth(ctx context.Context, in *GetTokenHealthRequest, opts ...grpc.CallOption) (*GetTokenHealthResponse, error)
 }
 
 type repositoryServiceClient struct {
@@ -107,6 +153,259 @@ func (c *repositoryServiceClient) SyncCommitsAll(ctx context.Context, in *SyncCo
 	return out, nil
 }
 
+func (c *repositoryServiceClient) StreamSync(ctx context.Context, in *StreamSyncRequest, opts ...grpc.CallOption) (RepositoryService_StreamSyncClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RepositoryService_ServiceDesc.Streams[0], RepositoryService_StreamSync_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &repositoryServiceStreamSyncClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RepositoryService_StreamSyncClient interface {
+	Recv() (*SyncEvent, error)
+	grpc.ClientStream
+}
+
+type repositoryServiceStreamSyncClient struct {
+	grpc.ClientStream
+}
+
+func (x *repositoryServiceStreamSyncClient) Recv() (*SyncEvent, error) {
+	m := new(SyncEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *repositoryServiceClient) BackfillCommits(ctx context.Context, in *BackfillCommitsRequest, opts ...grpc.CallOption) (*SyncCommitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncCommitsResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_BackfillCommits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) GetRateLimit(ctx context.Context, in *GetRateLimitRequest, opts ...grpc.CallOption) (*GetRateLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRateLimitResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_GetRateLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) ListSyncJobs(ctx context.Context, in *ListSyncJobsRequest, opts ...grpc.CallOption) (*ListSyncJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSyncJobsResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_ListSyncJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) GetSyncJob(ctx context.Context, in *GetSyncJobRequest, opts ...grpc.CallOption) (*GetSyncJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSyncJobResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_GetSyncJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) CancelSyncJob(ctx context.Context, in *CancelSyncJobRequest, opts ...grpc.CallOption) (*CancelSyncJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelSyncJobResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_CancelSyncJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) GetReleases(ctx context.Context, in *GetReleasesRequest, opts ...grpc.CallOption) (*GetReleasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReleasesResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_GetReleases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) GetTags(ctx context.Context, in *GetTagsRequest, opts ...grpc.CallOption) (*GetTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTagsResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_GetTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) GetBranches(ctx context.Context, in *GetBranchesRequest, opts ...grpc.CallOption) (*GetBranchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBranchesResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_GetBranches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) GetContributors(ctx context.Context, in *GetContributorsRequest, opts ...grpc.CallOption) (*GetContributorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetContributorsResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_GetContributors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) GetContributorLeaderboard(ctx context.Context, in *GetContributorLeaderboardRequest, opts ...grpc.CallOption) (*GetContributorLeaderboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetContributorLeaderboardResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_GetContributorLeaderboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) ListIssues(ctx context.Context, in *ListIssuesRequest, opts ...grpc.CallOption) (*ListIssuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIssuesResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_ListIssues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) ListPullRequests(ctx context.Context, in *ListIssuesRequest, opts ...grpc.CallOption) (*ListPullRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPullRequestsResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_ListPullRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) GetLanguages(ctx context.Context, in *GetLanguagesRequest, opts ...grpc.CallOption) (*GetLanguagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLanguagesResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_GetLanguages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) GetLanguageTotals(ctx context.Context, in *GetLanguageTotalsRequest, opts ...grpc.CallOption) (*GetLanguageTotalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLanguageTotalsResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_GetLanguageTotals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) GetMetricsSeries(ctx context.Context, in *GetMetricsSeriesRequest, opts ...grpc.CallOption) (*GetMetricsSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMetricsSeriesResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_GetMetricsSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) GetReadme(ctx context.Context, in *GetReadmeRequest, opts ...grpc.CallOption) (*GetReadmeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReadmeResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_GetReadme_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) GetWorkflows(ctx context.Context, in *GetWorkflowsRequest, opts ...grpc.CallOption) (*GetWorkflowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkflowsResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_GetWorkflows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) GetActionsSummary(ctx context.Context, in *GetActionsSummaryRequest, opts ...grpc.CallOption) (*GetActionsSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetActionsSummaryResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_GetActionsSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) GetCommitDetail(ctx context.Context, in *GetCommitDetailRequest, opts ...grpc.CallOption) (*GetCommitDetailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommitDetailResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_GetCommitDetail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) GetChurnStats(ctx context.Context, in *GetChurnStatsRequest, opts ...grpc.CallOption) (*GetChurnStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChurnStatsResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_GetChurnStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) GetDiscoveredRepositories(ctx context.Context, in *GetDiscoveredRepositoriesRequest, opts ...grpc.CallOption) (*GetDiscoveredRepositoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDiscoveredRepositoriesResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_GetDiscoveredRepositories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) GetTokenHealth(ctx context.Context, in *GetTokenHealthRequest, opts ...grpc.CallOption) (*GetTokenHealthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTokenHealthResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_GetTokenHealth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RepositoryServiceServer is the server API for RepositoryService service.
 // All implementations must embed UnimplementedRepositoryServiceServer
 // for forward compatibility
@@ -117,6 +416,29 @@ type RepositoryServiceServer interface {
 	GetCommits(context.Context, *GetCommitsRequest) (*GetCommitsResponse, error)
 	SyncCommits(context.Context, *SyncCommitsRequest) (*SyncCommitsResponse, error)
 	SyncCommitsAll(context.Context, *SyncCommitsAllRequest) (*SyncCommitsResponse, error)
+	StreamSync(*StreamSyncRequest, RepositoryService_StreamSyncServer) error
+	BackfillCommits(context.Context, *BackfillCommitsRequest) (*SyncCommitsResponse, error)
+	GetRateLimit(context.Context, *GetRateLimitRequest) (*GetRateLimitResponse, error)
+	ListSyncJobs(context.Context, *ListSyncJobsRequest) (*ListSyncJobsResponse, error)
+	GetSyncJob(context.Context, *GetSyncJobRequest) (*GetSyncJobResponse, error)
+	CancelSyncJob(context.Context, *CancelSyncJobRequest) (*CancelSyncJobResponse, error)
+	GetReleases(context.Context, *GetReleasesRequest) (*GetReleasesResponse, error)
+	GetTags(context.Context, *GetTagsRequest) (*GetTagsResponse, error)
+	GetBranches(context.Context, *GetBranchesRequest) (*GetBranchesResponse, error)
+	GetContributors(context.Context, *GetContributorsRequest) (*GetContributorsResponse, error)
+	GetContributorLeaderboard(context.Context, *GetContributorLeaderboardRequest) (*GetContributorLeaderboardResponse, error)
+	ListIssues(context.Context, *ListIssuesRequest) (*ListIssuesResponse, error)
+	ListPullRequests(context.Context, *ListIssuesRequest) (*ListPullRequestsResponse, error)
+	GetLanguages(context.Context, *GetLanguagesRequest) (*GetLanguagesResponse, error)
+	GetLanguageTotals(context.Context, *GetLanguageTotalsRequest) (*GetLanguageTotalsResponse, error)
+	GetMetricsSeries(context.Context, *GetMetricsSeriesRequest) (*GetMetricsSeriesResponse, error)
+	GetReadme(context.Context, *GetReadmeRequest) (*GetReadmeResponse, error)
+	GetWorkflows(context.Context, *GetWorkflowsRequest) (*GetWorkflowsResponse, error)
+	GetActionsSummary(context.Context, *GetActionsSummaryRequest) (*GetActionsSummaryResponse, error)
+	GetCommitDetail(context.Context, *GetCommitDetailRequest) (*GetCommitDetailResponse, error)
+	GetChurnStats(context.Context, *GetChurnStatsRequest) (*GetChurnStatsResponse, error)
+	GetDiscoveredRepositories(context.Context, *GetDiscoveredRepositoriesRequest) (*GetDiscoveredRepositoriesResponse, error)
+	GetTokenHealth(context.Context, *GetTokenHealthRequest) (*GetTokenHealthResponse, error)
 	mustEmbedUnimplementedRepositoryServiceServer()
 }
 
@@ -142,6 +464,75 @@ func (UnimplementedRepositoryServiceServer) SyncCommits(context.Context, *SyncCo
 func (UnimplementedRepositoryServiceServer) SyncCommitsAll(context.Context, *SyncCommitsAllRequest) (*SyncCommitsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncCommitsAll not implemented")
 }
+func (UnimplementedRepositoryServiceServer) StreamSync(*StreamSyncRequest, RepositoryService_StreamSyncServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamSync not implemented")
+}
+func (UnimplementedRepositoryServiceServer) BackfillCommits(context.Context, *BackfillCommitsRequest) (*SyncCommitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackfillCommits not implemented")
+}
+func (UnimplementedRepositoryServiceServer) GetRateLimit(context.Context, *GetRateLimitRequest) (*GetRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRateLimit not implemented")
+}
+func (UnimplementedRepositoryServiceServer) ListSyncJobs(context.Context, *ListSyncJobsRequest) (*ListSyncJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSyncJobs not implemented")
+}
+func (UnimplementedRepositoryServiceServer) GetSyncJob(context.Context, *GetSyncJobRequest) (*GetSyncJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSyncJob not implemented")
+}
+func (UnimplementedRepositoryServiceServer) CancelSyncJob(context.Context, *CancelSyncJobRequest) (*CancelSyncJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSyncJob not implemented")
+}
+func (UnimplementedRepositoryServiceServer) GetReleases(context.Context, *GetReleasesRequest) (*GetReleasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReleases not implemented")
+}
+func (UnimplementedRepositoryServiceServer) GetTags(context.Context, *GetTagsRequest) (*GetTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTags not implemented")
+}
+func (UnimplementedRepositoryServiceServer) GetBranches(context.Context, *GetBranchesRequest) (*GetBranchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBranches not implemented")
+}
+func (UnimplementedRepositoryServiceServer) GetContributors(context.Context, *GetContributorsRequest) (*GetContributorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContributors not implemented")
+}
+func (UnimplementedRepositoryServiceServer) GetContributorLeaderboard(context.Context, *GetContributorLeaderboardRequest) (*GetContributorLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContributorLeaderboard not implemented")
+}
+func (UnimplementedRepositoryServiceServer) ListIssues(context.Context, *ListIssuesRequest) (*ListIssuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIssues not implemented")
+}
+func (UnimplementedRepositoryServiceServer) ListPullRequests(context.Context, *ListIssuesRequest) (*ListPullRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPullRequests not implemented")
+}
+func (UnimplementedRepositoryServiceServer) GetLanguages(context.Context, *GetLanguagesRequest) (*GetLanguagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLanguages not implemented")
+}
+func (UnimplementedRepositoryServiceServer) GetLanguageTotals(context.Context, *GetLanguageTotalsRequest) (*GetLanguageTotalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLanguageTotals not implemented")
+}
+func (UnimplementedRepositoryServiceServer) GetMetricsSeries(context.Context, *GetMetricsSeriesRequest) (*GetMetricsSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetricsSeries not implemented")
+}
+func (UnimplementedRepositoryServiceServer) GetReadme(context.Context, *GetReadmeRequest) (*GetReadmeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReadme not implemented")
+}
+func (UnimplementedRepositoryServiceServer) GetWorkflows(context.Context, *GetWorkflowsRequest) (*GetWorkflowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflows not implemented")
+}
+func (UnimplementedRepositoryServiceServer) GetActionsSummary(context.Context, *GetActionsSummaryRequest) (*GetActionsSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActionsSummary not implemented")
+}
+func (UnimplementedRepositoryServiceServer) GetCommitDetail(context.Context, *GetCommitDetailRequest) (*GetCommitDetailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommitDetail not implemented")
+}
+func (UnimplementedRepositoryServiceServer) GetChurnStats(context.Context, *GetChurnStatsRequest) (*GetChurnStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChurnStats not implemented")
+}
+func (UnimplementedRepositoryServiceServer) GetDiscoveredRepositories(context.Context, *GetDiscoveredRepositoriesRequest) (*GetDiscoveredRepositoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiscoveredRepositories not implemented")
+}
+func (UnimplementedRepositoryServiceServer) GetTokenHealth(context.Context, *GetTokenHealthRequest) (*GetTokenHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTokenHealth not implemented")
+}
 func (UnimplementedRepositoryServiceServer) mustEmbedUnimplementedRepositoryServiceServer() {}
 
 // UnsafeRepositoryServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -263,38 +654,549 @@ func _RepositoryService_SyncCommitsAll_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
-// RepositoryService_ServiceDesc is the grpc.ServiceDesc for RepositoryService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var RepositoryService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "proto.RepositoryService",
-	HandlerType: (*RepositoryServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetRepositories",
-			Handler:    _RepositoryService_GetRepositories_Handler,
-		},
-		{
-			MethodName: "GetRepository",
-			Handler:    _RepositoryService_GetRepository_Handler,
-		},
-		{
-			MethodName: "SyncRepositories",
-			Handler:    _RepositoryService_SyncRepositories_Handler,
-		},
-		{
-			MethodName: "GetCommits",
-			Handler:    _RepositoryService_GetCommits_Handler,
-		},
-		{
-			MethodName: "SyncCommits",
-			Handler:    _RepositoryService_SyncCommits_Handler,
-		},
-		{
-			MethodName: "SyncCommitsAll",
-			Handler:    _RepositoryService_SyncCommitsAll_Handler,
+func _RepositoryService_StreamSync_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamSyncRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RepositoryServiceServer).StreamSync(m, &repositoryServiceStreamSyncServer{ServerStream: stream})
+}
+
+type RepositoryService_StreamSyncServer interface {
+	Send(*SyncEvent) error
+	grpc.ServerStream
+}
+
+type repositoryServiceStreamSyncServer struct {
+	grpc.ServerStream
+}
+
+func (x *repositoryServiceStreamSyncServer) Send(m *SyncEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RepositoryService_BackfillCommits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackfillCommitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).BackfillCommits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_BackfillCommits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).BackfillCommits(ctx, req.(*BackfillCommitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_GetRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).GetRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_GetRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).GetRateLimit(ctx, req.(*GetRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_ListSyncJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSyncJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).ListSyncJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_ListSyncJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).ListSyncJobs(ctx, req.(*ListSyncJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_GetSyncJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSyncJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).GetSyncJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_GetSyncJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).GetSyncJob(ctx, req.(*GetSyncJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_CancelSyncJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSyncJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).CancelSyncJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_CancelSyncJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).CancelSyncJob(ctx, req.(*CancelSyncJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_GetReleases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).GetReleases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_GetReleases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).GetReleases(ctx, req.(*GetReleasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_GetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).GetTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_GetTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).GetTags(ctx, req.(*GetTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_GetBranches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBranchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).GetBranches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_GetBranches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).GetBranches(ctx, req.(*GetBranchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_GetContributors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContributorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).GetContributors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_GetContributors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).GetContributors(ctx, req.(*GetContributorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_GetContributorLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContributorLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).GetContributorLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_GetContributorLeaderboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).GetContributorLeaderboard(ctx, req.(*GetContributorLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_ListIssues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIssuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).ListIssues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_ListIssues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).ListIssues(ctx, req.(*ListIssuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_ListPullRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIssuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).ListPullRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_ListPullRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).ListPullRequests(ctx, req.(*ListIssuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_GetLanguages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLanguagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).GetLanguages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_GetLanguages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).GetLanguages(ctx, req.(*GetLanguagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_GetLanguageTotals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLanguageTotalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).GetLanguageTotals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_GetLanguageTotals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).GetLanguageTotals(ctx, req.(*GetLanguageTotalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_GetMetricsSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetricsSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).GetMetricsSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_GetMetricsSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).GetMetricsSeries(ctx, req.(*GetMetricsSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_GetReadme_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReadmeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).GetReadme(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_GetReadme_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).GetReadme(ctx, req.(*GetReadmeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_GetWorkflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).GetWorkflows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_GetWorkflows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).GetWorkflows(ctx, req.(*GetWorkflowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_GetActionsSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActionsSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).GetActionsSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_GetActionsSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).GetActionsSummary(ctx, req.(*GetActionsSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_GetCommitDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommitDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).GetCommitDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_GetCommitDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).GetCommitDetail(ctx, req.(*GetCommitDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_GetChurnStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChurnStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).GetChurnStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_GetChurnStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).GetChurnStats(ctx, req.(*GetChurnStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_GetDiscoveredRepositories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiscoveredRepositoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).GetDiscoveredRepositories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_GetDiscoveredRepositories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).GetDiscoveredRepositories(ctx, req.(*GetDiscoveredRepositoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_GetTokenHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).GetTokenHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_GetTokenHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).GetTokenHealth(ctx, req.(*GetTokenHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RepositoryService_ServiceDesc is the grpc.ServiceDesc for RepositoryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RepositoryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.RepositoryService",
+	HandlerType: (*RepositoryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRepositories",
+			Handler:    _RepositoryService_GetRepositories_Handler,
+		},
+		{
+			MethodName: "GetRepository",
+			Handler:    _RepositoryService_GetRepository_Handler,
+		},
+		{
+			MethodName: "SyncRepositories",
+			Handler:    _RepositoryService_SyncRepositories_Handler,
+		},
+		{
+			MethodName: "GetCommits",
+			Handler:    _RepositoryService_GetCommits_Handler,
+		},
+		{
+			MethodName: "SyncCommits",
+			Handler:    _RepositoryService_SyncCommits_Handler,
+		},
+		{
+			MethodName: "SyncCommitsAll",
+			Handler:    _RepositoryService_SyncCommitsAll_Handler,
+		},
+		{
+			MethodName: "BackfillCommits",
+			Handler:    _RepositoryService_BackfillCommits_Handler,
+		},
+		{
+			MethodName: "GetRateLimit",
+			Handler:    _RepositoryService_GetRateLimit_Handler,
+		},
+		{
+			MethodName: "ListSyncJobs",
+			Handler:    _RepositoryService_ListSyncJobs_Handler,
+		},
+		{
+			MethodName: "GetSyncJob",
+			Handler:    _RepositoryService_GetSyncJob_Handler,
+		},
+		{
+			MethodName: "CancelSyncJob",
+			Handler:    _RepositoryService_CancelSyncJob_Handler,
+		},
+		{
+			MethodName: "GetReleases",
+			Handler:    _RepositoryService_GetReleases_Handler,
+		},
+		{
+			MethodName: "GetTags",
+			Handler:    _RepositoryService_GetTags_Handler,
+		},
+		{
+			MethodName: "GetBranches",
+			Handler:    _RepositoryService_GetBranches_Handler,
+		},
+		{
+			MethodName: "GetContributors",
+			Handler:    _RepositoryService_GetContributors_Handler,
+		},
+		{
+			MethodName: "GetContributorLeaderboard",
+			Handler:    _RepositoryService_GetContributorLeaderboard_Handler,
+		},
+		{
+			MethodName: "ListIssues",
+			Handler:    _RepositoryService_ListIssues_Handler,
+		},
+		{
+			MethodName: "ListPullRequests",
+			Handler:    _RepositoryService_ListPullRequests_Handler,
+		},
+		{
+			MethodName: "GetLanguages",
+			Handler:    _RepositoryService_GetLanguages_Handler,
+		},
+		{
+			MethodName: "GetLanguageTotals",
+			Handler:    _RepositoryService_GetLanguageTotals_Handler,
+		},
+		{
+			MethodName: "GetMetricsSeries",
+			Handler:    _RepositoryService_GetMetricsSeries_Handler,
+		},
+		{
+			MethodName: "GetReadme",
+			Handler:    _RepositoryService_GetReadme_Handler,
+		},
+		{
+			MethodName: "GetWorkflows",
+			Handler:    _RepositoryService_GetWorkflows_Handler,
+		},
+		{
+			MethodName: "GetActionsSummary",
+			Handler:    _RepositoryService_GetActionsSummary_Handler,
+		},
+		{
+			MethodName: "GetCommitDetail",
+			Handler:    _RepositoryService_GetCommitDetail_Handler,
+		},
+		{
+			MethodName: "GetChurnStats",
+			Handler:    _RepositoryService_GetChurnStats_Handler,
+		},
+		{
+			MethodName: "GetDiscoveredRepositories",
+			Handler:    _RepositoryService_GetDiscoveredRepositories_Handler,
+		},
+		{
+			MethodName: "GetTokenHealth",
+			Handler:    _RepositoryService_GetTokenHealth_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamSync",
+			Handler:       _RepositoryService_StreamSync_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/repository.proto",
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"sync"
 
 	"twt/config"
 	"twt/models"
@@ -19,15 +20,48 @@ type GRPCServer struct {
 	proto.UnimplementedRepositoryServiceServer
 	db            *models.DB
 	githubService *services.GitHubService
+	jobs          *services.JobManager
 }
 
-func NewGRPCServer(db *models.DB, githubService *services.GitHubService) *GRPCServer {
+func NewGRPCServer(db *models.DB, githubService *services.GitHubService, jobs *services.JobManager) *GRPCServer {
 	return &GRPCServer{
 		db:            db,
 		githubService: githubService,
+		jobs:          jobs,
 	}
 }
 
+func toProtoRepository(repo *models.Repository) *proto.Repository {
+	protoRepo := &proto.Repository{
+		Id:              int32(repo.ID),
+		Name:            repo.Name,
+		FullName:        repo.FullName,
+		Description:     repo.Description,
+		Url:             repo.URL,
+		Homepage:        repo.Homepage,
+		Language:        repo.Language,
+		Topics:          repo.Topics,
+		License:         repo.License,
+		DefaultBranch:   repo.DefaultBranch,
+		Archived:        repo.Archived,
+		Disabled:        repo.Disabled,
+		Fork:            repo.Fork,
+		Private:         repo.Private,
+		Stars:           int32(repo.Stars),
+		Forks:           int32(repo.Forks),
+		Watchers:        int32(repo.Watchers),
+		OpenIssuesCount: int32(repo.OpenIssues),
+		Size:            int32(repo.Size),
+		CreatedAt:       timestamppb.New(repo.CreatedAt),
+		UpdatedAt:       timestamppb.New(repo.UpdatedAt),
+		SyncedAt:        timestamppb.New(repo.SyncedAt),
+	}
+	if repo.PushedAt != nil {
+		protoRepo.PushedAt = timestamppb.New(*repo.PushedAt)
+	}
+	return protoRepo
+}
+
 func (s *GRPCServer) GetRepositories(ctx context.Context, req *proto.GetRepositoriesRequest) (*proto.GetRepositoriesResponse, error) {
 	repos, err := s.db.GetRepositories()
 	if err != nil {
@@ -36,20 +70,7 @@ func (s *GRPCServer) GetRepositories(ctx context.Context, req *proto.GetReposito
 
 	var protoRepos []*proto.Repository
 	for _, repo := range repos {
-		protoRepo := &proto.Repository{
-			Id:          int32(repo.ID),
-			Name:        repo.Name,
-			FullName:    repo.FullName,
-			Description: repo.Description,
-			Url:         repo.URL,
-			Language:    repo.Language,
-			Stars:       int32(repo.Stars),
-			Forks:       int32(repo.Forks),
-			CreatedAt:   timestamppb.New(repo.CreatedAt),
-			UpdatedAt:   timestamppb.New(repo.UpdatedAt),
-			SyncedAt:    timestamppb.New(repo.SyncedAt),
-		}
-		protoRepos = append(protoRepos, protoRepo)
+		protoRepos = append(protoRepos, toProtoRepository(repo))
 	}
 
 	return &proto.GetRepositoriesResponse{
@@ -64,62 +85,54 @@ func (s *GRPCServer) GetRepository(ctx context.Context, req *proto.GetRepository
 		return nil, fmt.Errorf("failed to get repository: %w", err)
 	}
 
-	protoRepo := &proto.Repository{
-		Id:          int32(repo.ID),
-		Name:        repo.Name,
-		FullName:    repo.FullName,
-		Description: repo.Description,
-		Url:         repo.URL,
-		Language:    repo.Language,
-		Stars:       int32(repo.Stars),
-		Forks:       int32(repo.Forks),
-		CreatedAt:   timestamppb.New(repo.CreatedAt),
-		UpdatedAt:   timestamppb.New(repo.UpdatedAt),
-		SyncedAt:    timestamppb.New(repo.SyncedAt),
-	}
-
 	return &proto.GetRepositoryResponse{
-		Repository: protoRepo,
+		Repository: toProtoRepository(repo),
 	}, nil
 }
 
 func (s *GRPCServer) SyncRepositories(ctx context.Context, req *proto.SyncRepositoriesRequest) (*proto.SyncRepositoriesResponse, error) {
 	repoURLs := req.RepositoryUrls
 	if len(repoURLs) == 0 {
-		// Use URLs from config if none provided
-		cfg := config.GetConfig()
-		repoURLs = cfg.Github.Repositories
+		// Use the configured and discovered repositories if none provided
+		repoURLs = s.githubService.ResolveRepositories(ctx, s.db)
 	}
 
-	syncedCount, err := s.githubService.SyncRepositories(repoURLs, s.db)
-	if err != nil {
-		return nil, fmt.Errorf("failed to sync repositories: %w", err)
+	task := services.RepositoriesTask(repoURLs)
+	if req.Async {
+		job := s.jobs.Start(services.TriggerGRPC, task)
+		return &proto.SyncRepositoriesResponse{
+			JobId:   job.ID,
+			Message: fmt.Sprintf("Started %s sync job", task.Kind),
+			Status:  job.Status,
+		}, nil
 	}
+	job, report := s.jobs.Run(services.TriggerGRPC, task)
 
 	return &proto.SyncRepositoriesResponse{
-		Message:     fmt.Sprintf("Successfully synced %d repositories", syncedCount),
-		SyncedCount: int32(syncedCount),
+		JobId:       job.ID,
+		Message:     fmt.Sprintf("Successfully synced %d repositories", report.Succeeded),
+		SyncedCount: int32(report.Succeeded),
+		Status:      report.Status,
+		FailedCount: int32(report.Failed),
+		Results:     toProtoSyncResults(report.Results),
 	}, nil
 }
 
 func (s *GRPCServer) GetCommits(ctx context.Context, req *proto.GetCommitsRequest) (*proto.GetCommitsResponse, error) {
-	commits, err := s.db.GetCommits(req.RepositoryFullName, int(req.Limit), int(req.Offset))
+	var commits []*models.Commit
+	var err error
+	if req.Branch != "" {
+		commits, err = s.db.GetBranchCommits(req.RepositoryFullName, req.Branch, int(req.Limit), int(req.Offset))
+	} else {
+		commits, err = s.db.GetCommits(req.RepositoryFullName, int(req.Limit), int(req.Offset))
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get commits: %w", err)
 	}
 
 	var protoCommits []*proto.Commit
 	for _, commit := range commits {
-		protoCommit := &proto.Commit{
-			Id:          int32(commit.ID),
-			Message:     commit.Message,
-			Sha:         commit.SHA,
-			AuthorName:  commit.AuthorName,
-			AuthorEmail: commit.AuthorEmail,
-			CommitDate:  timestamppb.New(commit.CommitDate),
-			SyncedAt:    timestamppb.New(commit.SyncedAt),
-		}
-		protoCommits = append(protoCommits, protoCommit)
+		protoCommits = append(protoCommits, toProtoCommit(commit))
 	}
 	return &proto.GetCommitsResponse{
 		Commits: protoCommits,
@@ -127,35 +140,773 @@ func (s *GRPCServer) GetCommits(ctx context.Context, req *proto.GetCommitsReques
 	}, nil
 }
 
-func (s *GRPCServer) SyncCommits(ctx context.Context, req *proto.SyncCommitsRequest) (*proto.SyncCommitsResponse, error) {
-	syncedCount, err := s.githubService.SyncCommits(req.RepositoryFullName, int(req.Limit), s.db)
+func (s *GRPCServer) GetReleases(ctx context.Context, req *proto.GetReleasesRequest) (*proto.GetReleasesResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 50
+	}
+	releases, err := s.db.GetReleases(req.RepositoryFullName, limit, int(req.Offset))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get releases: %w", err)
+	}
+	latest, err := s.db.GetLatestRelease(req.RepositoryFullName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest release: %w", err)
+	}
+	count, err := s.db.GetReleaseCount(req.RepositoryFullName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get releases: %w", err)
+	}
+
+	var protoReleases []*proto.Release
+	for _, release := range releases {
+		protoReleases = append(protoReleases, toProtoRelease(release))
+	}
+	resp := &proto.GetReleasesResponse{
+		Releases: protoReleases,
+		Total:    int32(count),
+	}
+	if latest != nil {
+		resp.Latest = toProtoRelease(latest)
+	}
+	return resp, nil
+}
+
+func toProtoRelease(release *models.Release) *proto.Release {
+	protoRelease := &proto.Release{
+		Id:                 int32(release.ID),
+		ReleaseId:          release.ReleaseID,
+		RepositoryFullName: release.RepositoryFullName,
+		TagName:            release.TagName,
+		Name:               release.Name,
+		Body:               release.Body,
+		Draft:              release.Draft,
+		Prerelease:         release.Prerelease,
+		Author:             release.Author,
+		Url:                release.URL,
+		CreatedAt:          timestamppb.New(release.CreatedAt),
+		SyncedAt:           timestamppb.New(release.SyncedAt),
+	}
+	if release.PublishedAt != nil {
+		protoRelease.PublishedAt = timestamppb.New(*release.PublishedAt)
+	}
+	return protoRelease
+}
+
+func (s *GRPCServer) GetTags(ctx context.Context, req *proto.GetTagsRequest) (*proto.GetTagsResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 50
+	}
+	tags, err := s.db.GetTags(req.RepositoryFullName, limit, int(req.Offset))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+	count, err := s.db.GetTagCount(req.RepositoryFullName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+
+	var protoTags []*proto.Tag
+	for _, tag := range tags {
+		protoTags = append(protoTags, &proto.Tag{
+			Id:                 int32(tag.ID),
+			RepositoryFullName: tag.RepositoryFullName,
+			Name:               tag.Name,
+			CommitSha:          tag.CommitSHA,
+			SyncedAt:           timestamppb.New(tag.SyncedAt),
+		})
+	}
+	return &proto.GetTagsResponse{
+		Tags:  protoTags,
+		Total: int32(count),
+	}, nil
+}
+
+func (s *GRPCServer) GetBranches(ctx context.Context, req *proto.GetBranchesRequest) (*proto.GetBranchesResponse, error) {
+	branches, err := s.db.GetBranches(req.RepositoryFullName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get branches: %w", err)
+	}
+
+	var protoBranches []*proto.Branch
+	for _, branch := range branches {
+		protoBranches = append(protoBranches, &proto.Branch{
+			Id:                 int32(branch.ID),
+			RepositoryFullName: branch.RepositoryFullName,
+			Name:               branch.Name,
+			HeadSha:            branch.HeadSHA,
+			Protected:          branch.Protected,
+			SyncedAt:           timestamppb.New(branch.SyncedAt),
+		})
+	}
+	return &proto.GetBranchesResponse{
+		Branches: protoBranches,
+		Total:    int32(len(protoBranches)),
+	}, nil
+}
+
+func (s *GRPCServer) GetContributors(ctx context.Context, req *proto.GetContributorsRequest) (*proto.GetContributorsResponse, error) {
+	contributors, err := s.db.GetContributors(req.RepositoryFullName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get contributors: %w", err)
+	}
+	authors, err := s.db.GetAuthorStats(req.RepositoryFullName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get author statistics: %w", err)
+	}
+
+	resp := &proto.GetContributorsResponse{}
+	for _, c := range contributors {
+		resp.Contributors = append(resp.Contributors, &proto.Contributor{
+			Id:                 int32(c.ID),
+			RepositoryFullName: c.RepositoryFullName,
+			Login:              c.Login,
+			AvatarUrl:          c.AvatarURL,
+			Url:                c.URL,
+			Contributions:      int32(c.Contributions),
+			Additions:          int32(c.Additions),
+			Deletions:          int32(c.Deletions),
+			SyncedAt:           timestamppb.New(c.SyncedAt),
+		})
+	}
+	for _, a := range authors {
+		resp.Authors = append(resp.Authors, &proto.AuthorStats{
+			Name:            a.Name,
+			Email:           a.Email,
+			Commits:         int32(a.Commits),
+			FirstCommit:     timestamppb.New(a.FirstCommit),
+			LastCommit:      timestamppb.New(a.LastCommit),
+			Additions:       int32(a.Additions),
+			Deletions:       int32(a.Deletions),
+			DetailedCommits: int32(a.DetailedCommits),
+		})
+	}
+	return resp, nil
+}
+
+func (s *GRPCServer) GetContributorLeaderboard(ctx context.Context, req *proto.GetContributorLeaderboardRequest) (*proto.GetContributorLeaderboardResponse, error) {
+	sortBy := req.Sort
+	if sortBy == "" {
+		sortBy = "contributions"
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 20
+	}
+	entries, err := s.db.GetContributorLeaderboard(req.Owner, sortBy, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get contributor leaderboard: %w", err)
+	}
+
+	resp := &proto.GetContributorLeaderboardResponse{}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, &proto.LeaderboardEntry{
+			Login:         e.Login,
+			AvatarUrl:     e.AvatarURL,
+			Contributions: int32(e.Contributions),
+			Additions:     int32(e.Additions),
+			Deletions:     int32(e.Deletions),
+			Repositories:  int32(e.Repositories),
+		})
+	}
+	return resp, nil
+}
+
+func (s *GRPCServer) GetLanguages(ctx context.Context, req *proto.GetLanguagesRequest) (*proto.GetLanguagesResponse, error) {
+	languages, err := s.db.GetLanguages(req.RepositoryFullName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get languages: %w", err)
+	}
+
+	resp := &proto.GetLanguagesResponse{}
+	for _, l := range languages {
+		resp.Languages = append(resp.Languages, &proto.Language{
+			RepositoryFullName: l.RepositoryFullName,
+			Name:               l.Name,
+			Bytes:              l.Bytes,
+			Percentage:         l.Percentage,
+			SyncedAt:           timestamppb.New(l.SyncedAt),
+		})
+	}
+	return resp, nil
+}
+
+func toProtoFailureRate(r *models.FailureRate) *proto.FailureRate {
+	return &proto.FailureRate{
+		RepositoryFullName:     r.RepositoryFullName,
+		WorkflowId:             r.WorkflowID,
+		WorkflowName:           r.WorkflowName,
+		Runs:                   int32(r.Runs),
+		Failures:               int32(r.Failures),
+		FailureRate:            r.FailureRate,
+		AverageDurationSeconds: int32(r.AverageDuration),
+	}
+}
+
+func (s *GRPCServer) GetWorkflows(ctx context.Context, req *proto.GetWorkflowsRequest) (*proto.GetWorkflowsResponse, error) {
+	workflows, err := s.db.GetWorkflows(req.RepositoryFullName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workflows: %w", err)
+	}
+	rates, err := s.db.GetFailureRates(req.RepositoryFullName, services.FailureRateSince(int(req.Days)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get failure rates: %w", err)
+	}
+
+	resp := &proto.GetWorkflowsResponse{}
+	for _, w := range workflows {
+		protoWorkflow := &proto.Workflow{
+			Id:                 int32(w.ID),
+			WorkflowId:         w.WorkflowID,
+			RepositoryFullName: w.RepositoryFullName,
+			Name:               w.Name,
+			Path:               w.Path,
+			State:              w.State,
+			Url:                w.URL,
+			SyncedAt:           timestamppb.New(w.SyncedAt),
+		}
+		if run := w.LatestRun; run != nil {
+			protoWorkflow.LatestRun = &proto.WorkflowRun{
+				Id:                 int32(run.ID),
+				RunId:              run.RunID,
+				RepositoryFullName: run.RepositoryFullName,
+				WorkflowId:         run.WorkflowID,
+				Name:               run.Name,
+				RunNumber:          int32(run.RunNumber),
+				Event:              run.Event,
+				Branch:             run.Branch,
+				HeadSha:            run.HeadSHA,
+				Status:             run.Status,
+				Conclusion:         run.Conclusion,
+				Url:                run.URL,
+				CreatedAt:          timestamppb.New(run.CreatedAt),
+				UpdatedAt:          timestamppb.New(run.UpdatedAt),
+				DurationSeconds:    int32(run.Duration),
+				SyncedAt:           timestamppb.New(run.SyncedAt),
+			}
+			if run.StartedAt != nil {
+				protoWorkflow.LatestRun.StartedAt = timestamppb.New(*run.StartedAt)
+			}
+		}
+		resp.Workflows = append(resp.Workflows, protoWorkflow)
+	}
+	for _, r := range rates {
+		resp.FailureRates = append(resp.FailureRates, toProtoFailureRate(r))
+	}
+	return resp, nil
+}
+
+func (s *GRPCServer) GetActionsSummary(ctx context.Context, req *proto.GetActionsSummaryRequest) (*proto.GetActionsSummaryResponse, error) {
+	rates, err := s.db.GetFailureRates("", services.FailureRateSince(int(req.Days)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get failure rates: %w", err)
+	}
+
+	resp := &proto.GetActionsSummaryResponse{}
+	for _, r := range rates {
+		resp.Repositories = append(resp.Repositories, toProtoFailureRate(r))
+	}
+	return resp, nil
+}
+
+func toProtoCommit(commit *models.Commit) *proto.Commit {
+	return &proto.Commit{
+		Id:          int32(commit.ID),
+		Message:     commit.Message,
+		Sha:         commit.SHA,
+		AuthorName:  commit.AuthorName,
+		AuthorEmail: commit.AuthorEmail,
+		CommitDate:  timestamppb.New(commit.CommitDate),
+		SyncedAt:    timestamppb.New(commit.SyncedAt),
+	}
+}
+
+func (s *GRPCServer) GetCommitDetail(ctx context.Context, req *proto.GetCommitDetailRequest) (*proto.GetCommitDetailResponse, error) {
+	detail, err := s.db.GetCommitDetail(req.RepositoryFullName, req.Sha)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit: %w", err)
+	}
+	if detail == nil {
+		return nil, fmt.Errorf("commit %s of %s not found", req.Sha, req.RepositoryFullName)
+	}
+
+	protoDetail := &proto.CommitDetail{
+		Commit:    toProtoCommit(&detail.Commit),
+		Additions: int32(detail.Additions),
+		Deletions: int32(detail.Deletions),
+		Parents:   detail.Parents,
+	}
+	for _, f := range detail.Files {
+		protoDetail.Files = append(protoDetail.Files, &proto.CommitFile{
+			Filename:  f.Filename,
+			Status:    f.Status,
+			Additions: int32(f.Additions),
+			Deletions: int32(f.Deletions),
+		})
+	}
+	if detail.DetailsSyncedAt != nil {
+		protoDetail.DetailsSyncedAt = timestamppb.New(*detail.DetailsSyncedAt)
+	}
+	return &proto.GetCommitDetailResponse{Commit: protoDetail}, nil
+}
+
+func (s *GRPCServer) GetChurnStats(ctx context.Context, req *proto.GetChurnStatsRequest) (*proto.GetChurnStatsResponse, error) {
+	since, err := services.ParseSince(req.Since)
+	if err != nil {
+		return nil, err
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 20
+	}
+
+	stats, err := s.db.GetChurnStats(req.RepositoryFullName, since, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get churn statistics: %w", err)
+	}
+
+	resp := &proto.GetChurnStatsResponse{
+		RepositoryFullName: stats.RepositoryFullName,
+		Commits:            int32(stats.Commits),
+		Additions:          int32(stats.Additions),
+		Deletions:          int32(stats.Deletions),
+		FilesChanged:       int32(stats.FilesChanged),
+	}
+	for _, f := range stats.TopFiles {
+		resp.TopFiles = append(resp.TopFiles, &proto.FileChurn{
+			Filename:  f.Filename,
+			Commits:   int32(f.Commits),
+			Additions: int32(f.Additions),
+			Deletions: int32(f.Deletions),
+		})
+	}
+	return resp, nil
+}
+
+func (s *GRPCServer) GetDiscoveredRepositories(ctx context.Context, req *proto.GetDiscoveredRepositoriesRequest) (*proto.GetDiscoveredRepositoriesResponse, error) {
+	repos, err := s.db.GetDiscoveredRepositories(req.Disappeared)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get discovered repositories: %w", err)
+	}
+
+	resp := &proto.GetDiscoveredRepositoriesResponse{}
+	for _, r := range repos {
+		protoRepo := &proto.DiscoveredRepository{
+			Host:        r.Host,
+			FullName:    r.FullName,
+			Owner:       r.Owner,
+			Url:         r.URL,
+			Archived:    r.Archived,
+			Fork:        r.Fork,
+			FirstSeenAt: timestamppb.New(r.FirstSeenAt),
+			LastSeenAt:  timestamppb.New(r.LastSeenAt),
+		}
+		if r.DisappearedAt != nil {
+			protoRepo.DisappearedAt = timestamppb.New(*r.DisappearedAt)
+		}
+		resp.Repositories = append(resp.Repositories, protoRepo)
+	}
+	return resp, nil
+}
+
+func (s *GRPCServer) GetReadme(ctx context.Context, req *proto.GetReadmeRequest) (*proto.GetReadmeResponse, error) {
+	readme, err := s.db.GetReadme(req.RepositoryFullName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get README: %w", err)
+	}
+	if readme == nil {
+		return nil, fmt.Errorf("README of %s not found", req.RepositoryFullName)
+	}
+
+	return &proto.GetReadmeResponse{
+		Readme: &proto.Readme{
+			RepositoryFullName: readme.RepositoryFullName,
+			Path:               readme.Path,
+			Sha:                readme.SHA,
+			Content:            readme.Content,
+			Html:               readme.HTML,
+			Url:                readme.URL,
+			SyncedAt:           timestamppb.New(readme.SyncedAt),
+		},
+	}, nil
+}
+
+func (s *GRPCServer) GetMetricsSeries(ctx context.Context, req *proto.GetMetricsSeriesRequest) (*proto.GetMetricsSeriesResponse, error) {
+	from, to, err := services.ParseDateRange(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+	interval := req.Interval
+	if interval == "" {
+		interval = "day"
+	}
+
+	points, err := s.db.GetMetricsSeries(req.RepositoryFullName, from, to, interval)
 	if err != nil {
-		return nil, fmt.Errorf("failed to sync commits: %w", err)
+		return nil, fmt.Errorf("failed to get metrics: %w", err)
+	}
+
+	resp := &proto.GetMetricsSeriesResponse{}
+	for _, p := range points {
+		resp.Points = append(resp.Points, &proto.MetricsPoint{
+			Date:            p.Date,
+			Stars:           int32(p.Stars),
+			Forks:           int32(p.Forks),
+			Watchers:        int32(p.Watchers),
+			OpenIssuesCount: int32(p.OpenIssues),
+		})
+	}
+	return resp, nil
+}
+
+func (s *GRPCServer) GetLanguageTotals(ctx context.Context, req *proto.GetLanguageTotalsRequest) (*proto.GetLanguageTotalsResponse, error) {
+	totals, err := s.db.GetLanguageTotals(req.Owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get language totals: %w", err)
+	}
+
+	resp := &proto.GetLanguageTotalsResponse{}
+	for _, t := range totals {
+		resp.Languages = append(resp.Languages, &proto.LanguageTotal{
+			Name:         t.Name,
+			Bytes:        t.Bytes,
+			Percentage:   t.Percentage,
+			Repositories: int32(t.Repositories),
+		})
+	}
+	return resp, nil
+}
+
+func toIssueFilter(req *proto.ListIssuesRequest) models.IssueFilter {
+	filter := models.IssueFilter{
+		Repository: req.RepositoryFullName,
+		State:      req.State,
+		Label:      req.Label,
+		Author:     req.Author,
+		Limit:      int(req.Limit),
+		Offset:     int(req.Offset),
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = 50
+	}
+	return filter
+}
+
+func toProtoIssue(issue *models.Issue) *proto.Issue {
+	protoIssue := &proto.Issue{
+		Id:                 int32(issue.ID),
+		RepositoryFullName: issue.RepositoryFullName,
+		Number:             int32(issue.Number),
+		Title:              issue.Title,
+		State:              issue.State,
+		Author:             issue.Author,
+		Labels:             issue.Labels,
+		Assignees:          issue.Assignees,
+		Comments:           int32(issue.Comments),
+		Url:                issue.URL,
+		CreatedAt:          timestamppb.New(issue.CreatedAt),
+		UpdatedAt:          timestamppb.New(issue.UpdatedAt),
+		SyncedAt:           timestamppb.New(issue.SyncedAt),
+	}
+	if issue.ClosedAt != nil {
+		protoIssue.ClosedAt = timestamppb.New(*issue.ClosedAt)
+	}
+	return protoIssue
+}
+
+func (s *GRPCServer) ListIssues(ctx context.Context, req *proto.ListIssuesRequest) (*proto.ListIssuesResponse, error) {
+	issues, total, err := s.db.GetIssues(toIssueFilter(req))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issues: %w", err)
+	}
+
+	resp := &proto.ListIssuesResponse{Total: int32(total)}
+	for _, issue := range issues {
+		resp.Issues = append(resp.Issues, toProtoIssue(issue))
+	}
+	return resp, nil
+}
+
+func (s *GRPCServer) ListPullRequests(ctx context.Context, req *proto.ListIssuesRequest) (*proto.ListPullRequestsResponse, error) {
+	pulls, total, err := s.db.GetPullRequests(toIssueFilter(req))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull requests: %w", err)
+	}
+
+	resp := &proto.ListPullRequestsResponse{Total: int32(total)}
+	for _, pr := range pulls {
+		protoPR := &proto.PullRequest{
+			Issue: toProtoIssue(&pr.Issue),
+			Draft: pr.Draft,
+		}
+		if pr.MergedAt != nil {
+			protoPR.MergedAt = timestamppb.New(*pr.MergedAt)
+		}
+		resp.PullRequests = append(resp.PullRequests, protoPR)
+	}
+	return resp, nil
+}
+
+func (s *GRPCServer) SyncCommits(ctx context.Context, req *proto.SyncCommitsRequest) (*proto.SyncCommitsResponse, error) {
+	task := services.CommitsTask([]string{req.RepositoryFullName}, int(req.Limit))
+	if req.Async {
+		return s.startCommitsJob(task), nil
+	}
+	job, report := s.jobs.Run(services.TriggerGRPC, task)
+	result := report.Results[0]
+	if result.Status == services.SyncStatusFailed {
+		return nil, fmt.Errorf("failed to sync commits: %s", result.Error)
 	}
 	return &proto.SyncCommitsResponse{
-		Message:     fmt.Sprintf("Successfully synced %d commits", syncedCount),
-		SyncedCount: int32(syncedCount),
+		JobId:       job.ID,
+		Message:     fmt.Sprintf("Successfully synced %d commits", result.Added),
+		SyncedCount: int32(result.Added),
+		NewCount:    int32(result.Added),
+		KnownCount:  int32(result.Unchanged),
 	}, nil
 }
 
 func (s *GRPCServer) SyncCommitsAll(ctx context.Context, req *proto.SyncCommitsAllRequest) (*proto.SyncCommitsResponse, error) {
 	repoURLs := req.RepositoryUrls
 	if len(repoURLs) == 0 {
-		// Use URLs from config if none provided
-		cfg := config.GetConfig()
-		repoURLs = cfg.Github.Repositories
+		// Use the configured and discovered repositories if none provided
+		repoURLs = s.githubService.ResolveRepositories(ctx, s.db)
+	}
+	task := services.CommitsTask(repoURLs, int(req.Limit))
+	if req.Async {
+		return s.startCommitsJob(task), nil
+	}
+	job, report := s.jobs.Run(services.TriggerGRPC, task)
+	return &proto.SyncCommitsResponse{
+		JobId:       job.ID,
+		Message:     fmt.Sprintf("Successfully synced %d commits", report.Added),
+		SyncedCount: int32(report.Added),
+		NewCount:    int32(report.Added),
+		KnownCount:  int32(report.Unchanged),
+		Status:      report.Status,
+		FailedCount: int32(report.Failed),
+		Results:     toProtoSyncResults(report.Results),
+	}, nil
+}
+
+// startCommitsJob runs a commit sync or backfill in the background and
+// returns the job ID to poll with GetSyncJob.
+func (s *GRPCServer) startCommitsJob(task services.SyncTask) *proto.SyncCommitsResponse {
+	job := s.jobs.Start(services.TriggerGRPC, task)
+	return &proto.SyncCommitsResponse{
+		JobId:   job.ID,
+		Message: fmt.Sprintf("Started %s sync job", task.Kind),
+		Status:  job.Status,
 	}
-	syncedCount, err := s.githubService.SyncCommitsAll(repoURLs, int(req.Limit), s.db)
+}
+
+// StreamSync incrementally syncs commits like SyncCommitsAll and streams
+// progress events while it runs. The last event is sync_finished; closing the
+// stream cancels the sync.
+func (s *GRPCServer) StreamSync(req *proto.StreamSyncRequest, stream proto.RepositoryService_StreamSyncServer) error {
+	repoURLs := req.RepositoryUrls
+	if len(repoURLs) == 0 {
+		// Use the configured and discovered repositories if none provided
+		repoURLs = s.githubService.ResolveRepositories(stream.Context(), s.db)
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 50
+	}
+
+	// Events arrive from the worker goroutines, but Send is not safe for
+	// concurrent use
+	var mu sync.Mutex
+	send := func(event *proto.SyncEvent) error {
+		mu.Lock()
+		defer mu.Unlock()
+		event.Time = timestamppb.Now()
+		return stream.Send(event)
+	}
+
+	task := services.CommitsTask(repoURLs, limit).WithObserver(func(event services.SyncEvent) {
+		protoEvent := &proto.SyncEvent{
+			Type:       event.Type,
+			Repository: event.Repository,
+			Commits:    int32(event.Commits),
+		}
+		if event.Result != nil {
+			protoEvent.Result = toProtoSyncResult(event.Result)
+		}
+		if err := send(protoEvent); err != nil {
+			log.Printf("Failed to send sync event: %v", err)
+		}
+	})
+	job, report := s.jobs.RunContext(stream.Context(), services.TriggerGRPC, task)
+
+	return send(&proto.SyncEvent{
+		Type:   services.EventSyncFinished,
+		JobId:  job.ID,
+		Status: report.Status,
+	})
+}
+
+// toProtoSyncResults converts per-repository sync results. As with the HTTP
+// API, failed repositories are reported in the results instead of failing the
+// whole call.
+func toProtoSyncResults(results []*services.RepoSyncResult) []*proto.RepositorySyncResult {
+	var protoResults []*proto.RepositorySyncResult
+	for _, result := range results {
+		protoResults = append(protoResults, toProtoSyncResult(result))
+	}
+	return protoResults
+}
+
+func toProtoSyncResult(result *services.RepoSyncResult) *proto.RepositorySyncResult {
+	return &proto.RepositorySyncResult{
+		Repository: result.Repository,
+		Status:     result.Status,
+		Error:      result.Error,
+		Added:      int32(result.Added),
+		Updated:    int32(result.Updated),
+		Unchanged:  int32(result.Unchanged),
+		DurationMs: result.DurationMS,
+		Warnings:   result.Warnings,
+	}
+}
+
+func (s *GRPCServer) BackfillCommits(ctx context.Context, req *proto.BackfillCommitsRequest) (*proto.SyncCommitsResponse, error) {
+	sinceParam := req.Since
+	if sinceParam == "" {
+		sinceParam = config.GetConfig().Github.BackfillSince
+	}
+	since, err := services.ParseSince(sinceParam)
 	if err != nil {
-		return nil, fmt.Errorf("failed to sync commits: %w", err)
+		return nil, err
+	}
+
+	task := services.BackfillTask(req.RepositoryFullName, since)
+	if req.Async {
+		return s.startCommitsJob(task), nil
+	}
+	job, report := s.jobs.Run(services.TriggerGRPC, task)
+	result := report.Results[0]
+	if result.Status == services.SyncStatusFailed {
+		return nil, fmt.Errorf("failed to backfill commits: %s", result.Error)
 	}
 	return &proto.SyncCommitsResponse{
-		Message:     fmt.Sprintf("Successfully synced %d repositories", syncedCount),
-		SyncedCount: int32(syncedCount),
+		Message:     fmt.Sprintf("Successfully backfilled %d commits", result.Added),
+		SyncedCount: int32(result.Added),
+		JobId:       job.ID,
+	}, nil
+}
+
+func (s *GRPCServer) GetRateLimit(ctx context.Context, req *proto.GetRateLimitRequest) (*proto.GetRateLimitResponse, error) {
+	rateLimit, err := s.githubService.FetchRateLimit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch rate limit: %w", err)
+	}
+
+	return &proto.GetRateLimitResponse{
+		RateLimit: &proto.RateLimit{
+			Limit:     int32(rateLimit.Limit),
+			Remaining: int32(rateLimit.Remaining),
+			Used:      int32(rateLimit.Used),
+			ResetAt:   timestamppb.New(rateLimit.Reset),
+			UpdatedAt: timestamppb.New(rateLimit.UpdatedAt),
+		},
 	}, nil
 }
 
-func StartGRPCServer(db *models.DB, githubService *services.GitHubService) error {
+func (s *GRPCServer) GetTokenHealth(ctx context.Context, req *proto.GetTokenHealthRequest) (*proto.GetTokenHealthResponse, error) {
+	var tokens []*proto.TokenHealth
+	for _, h := range s.githubService.CheckTokens(ctx) {
+		token := &proto.TokenHealth{
+			Host:   h.Host,
+			Token:  h.Token,
+			Status: h.Status,
+			RateLimit: &proto.RateLimit{
+				Limit:     int32(h.RateLimit.Limit),
+				Remaining: int32(h.RateLimit.Remaining),
+				Used:      int32(h.RateLimit.Used),
+				ResetAt:   timestamppb.New(h.RateLimit.Reset),
+				UpdatedAt: timestamppb.New(h.RateLimit.UpdatedAt),
+			},
+			Requests: h.Requests,
+			Error:    h.Error,
+		}
+		if h.InvalidSince != nil {
+			token.InvalidSince = timestamppb.New(*h.InvalidSince)
+		}
+		tokens = append(tokens, token)
+	}
+
+	return &proto.GetTokenHealthResponse{Tokens: tokens}, nil
+}
+
+func (s *GRPCServer) ListSyncJobs(ctx context.Context, req *proto.ListSyncJobsRequest) (*proto.ListSyncJobsResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 50
+	}
+	jobs, err := s.jobs.ListJobs(limit, int(req.Offset))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sync jobs: %w", err)
+	}
+	count, err := s.db.GetSyncJobCount()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sync jobs: %w", err)
+	}
+
+	var protoJobs []*proto.SyncJob
+	for _, job := range jobs {
+		protoJobs = append(protoJobs, toProtoSyncJob(job))
+	}
+	return &proto.ListSyncJobsResponse{
+		Jobs:  protoJobs,
+		Total: int32(count),
+	}, nil
+}
+
+func (s *GRPCServer) GetSyncJob(ctx context.Context, req *proto.GetSyncJobRequest) (*proto.GetSyncJobResponse, error) {
+	job, err := s.jobs.GetJob(req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sync job: %w", err)
+	}
+	return &proto.GetSyncJobResponse{
+		Job: toProtoSyncJob(job),
+	}, nil
+}
+
+func (s *GRPCServer) CancelSyncJob(ctx context.Context, req *proto.CancelSyncJobRequest) (*proto.CancelSyncJobResponse, error) {
+	if err := s.jobs.Cancel(req.Id); err != nil {
+		return nil, fmt.Errorf("failed to cancel sync job: %w", err)
+	}
+	return &proto.CancelSyncJobResponse{
+		Message: fmt.Sprintf("Canceling sync job %d", req.Id),
+	}, nil
+}
+
+func toProtoSyncJob(job *models.SyncJob) *proto.SyncJob {
+	protoJob := &proto.SyncJob{
+		Id:                  job.ID,
+		Kind:                job.Kind,
+		Trigger:             job.Trigger,
+		Status:              job.Status,
+		Repositories:        job.Repositories,
+		Errors:              job.Errors,
+		Succeeded:           int32(job.Succeeded),
+		Failed:              int32(job.Failed),
+		Added:               int32(job.Added),
+		Updated:             int32(job.Updated),
+		Total:               int32(job.Total),
+		Done:                int32(job.Done),
+		CurrentRepositories: job.Current,
+		StartedAt:           timestamppb.New(job.StartedAt),
+	}
+	if job.FinishedAt != nil {
+		protoJob.FinishedAt = timestamppb.New(*job.FinishedAt)
+	}
+	return protoJob
+}
+
+func StartGRPCServer(db *models.DB, githubService *services.GitHubService, jobs *services.JobManager) error {
 	cfg := config.GetConfig()
 	address := cfg.Server.GRPC.Address
 
@@ -169,7 +920,7 @@ func StartGRPCServer(db *models.DB, githubService *services.GitHubService) error
 	}
 
 	s := grpc.NewServer()
-	grpcServer := NewGRPCServer(db, githubService)
+	grpcServer := NewGRPCServer(db, githubService, jobs)
 	proto.RegisterRepositoryServiceServer(s, grpcServer)
 
 	log.Printf("gRPC server starting on: %s", address)
@@ -1,9 +1,12 @@
 package server
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"twt/config"
 	"twt/models"
@@ -15,15 +18,20 @@ import (
 type HTTPServer struct {
 	db            *models.DB
 	githubService *services.GitHubService
+	jobs          *services.JobManager
 	router        *gin.Engine
 }
 
-func NewHTTPServer(db *models.DB, githubService *services.GitHubService) *HTTPServer {
+func NewHTTPServer(db *models.DB, githubService *services.GitHubService, jobs *services.JobManager) *HTTPServer {
 	gin.SetMode(gin.ReleaseMode)
 	router := gin.Default()
+	// Match routes on the escaped path so that an :owner of nested GitLab
+	// groups can be given as group%2Fsubgroup
+	router.UseRawPath = true
 	server := &HTTPServer{
 		db:            db,
 		githubService: githubService,
+		jobs:          jobs,
 		router:        router,
 	}
 
@@ -36,10 +44,33 @@ func (s *HTTPServer) setupRoutes() {
 	{
 		api.GET("/repositories", s.getRepositories)
 		api.GET("/repositories/:owner/:name", s.getRepository)
+		api.GET("/repositories/:owner/:name/contributors", s.getContributors)
+		api.GET("/repositories/:owner/:name/languages", s.getLanguages)
+		api.GET("/repositories/:owner/:name/metrics", s.getMetrics)
+		api.GET("/repositories/:owner/:name/readme", s.getReadme)
+		api.GET("/repositories/:owner/:name/churn", s.getChurnStats)
+		api.GET("/contributors/leaderboard", s.getContributorLeaderboard)
+		api.GET("/languages", s.getLanguageTotals)
 		api.GET("/commits/:owner/:name", s.getCommits)
+		api.GET("/commits/:owner/:name/:sha", s.getCommitDetail)
 		api.POST("/repositories/sync", s.syncRepositories)
+		api.GET("/releases/:owner/:name", s.getReleases)
+		api.GET("/branches/:owner/:name", s.getBranches)
+		api.GET("/issues", s.getIssues)
+		api.GET("/issues/:owner/:name", s.getIssues)
+		api.GET("/pulls", s.getPullRequests)
+		api.GET("/pulls/:owner/:name", s.getPullRequests)
+		api.GET("/actions", s.getActionsSummary)
+		api.GET("/actions/:owner/:name", s.getWorkflows)
+		api.GET("/discovery", s.getDiscoveredRepositories)
 		api.POST("/commits/sync/:owner/:name", s.syncCommits)
 		api.POST("/commits/sync", s.syncCommitsAll)
+		api.POST("/commits/backfill/:owner/:name", s.backfillCommits)
+		api.GET("/github/ratelimit", s.getRateLimit)
+		api.GET("/github/tokens", s.getTokenHealth)
+		api.GET("/sync/jobs", s.getSyncJobs)
+		api.GET("/sync/jobs/:id", s.getSyncJob)
+		api.POST("/sync/jobs/:id/cancel", s.cancelSyncJob)
 		api.GET("/health", s.healthCheck)
 	}
 }
@@ -60,10 +91,35 @@ func (s *HTTPServer) getRepositories(c *gin.Context) {
 	})
 }
 
+// repositoryFullName returns the full name addressed by the :owner and :name
+// parameters. Repositories outside github.com are selected with the host
+// query parameter, e.g. ?host=gitea.com. Projects in nested GitLab groups
+// escape the slashes of the owner, e.g. /group%2Fsub/project?host=gitlab.com.
+func repositoryFullName(c *gin.Context) string {
+	fullName := fmt.Sprintf("%s/%s", c.Param("owner"), c.Param("name"))
+	if host := strings.ToLower(c.Query("host")); host != "" && host != "github.com" {
+		return host + "/" + fullName
+	}
+	return fullName
+}
+
+// repositoryOwner returns the owner selected by the owner and host query
+// parameters, qualified with the host like repositoryFullName. A host without
+// owner selects all repositories of that host.
+func repositoryOwner(c *gin.Context) string {
+	owner := c.Query("owner")
+	host := strings.ToLower(c.Query("host"))
+	if host == "" || host == "github.com" {
+		return owner
+	}
+	if owner == "" {
+		return host
+	}
+	return host + "/" + owner
+}
+
 func (s *HTTPServer) getRepository(c *gin.Context) {
-	owner := c.Param("owner")
-	name := c.Param("name")
-	fullName := fmt.Sprintf("%s/%s", owner, name)
+	fullName := repositoryFullName(c)
 
 	repo, err := s.db.GetRepositoryByName(fullName)
 	if err != nil {
@@ -79,15 +135,73 @@ func (s *HTTPServer) getRepository(c *gin.Context) {
 	})
 }
 
+func (s *HTTPServer) getContributors(c *gin.Context) {
+	fullName := repositoryFullName(c)
+
+	contributors, err := s.db.GetContributors(fullName)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get contributors",
+			"details": err.Error(),
+		})
+		return
+	}
+	authors, err := s.db.GetAuthorStats(fullName)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get author statistics",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"contributors": contributors,
+		"authors":      authors,
+		"total":        len(contributors),
+	})
+}
+
+func (s *HTTPServer) getContributorLeaderboard(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	entries, err := s.db.GetContributorLeaderboard(repositoryOwner(c), c.DefaultQuery("sort", "contributions"), limit)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Failed to get contributor leaderboard",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"leaderboard": entries,
+		"total":       len(entries),
+	})
+}
+
 func (s *HTTPServer) getCommits(c *gin.Context) {
-	owner := c.Param("owner")
-	name := c.Param("name")
-	fullName := fmt.Sprintf("%s/%s", owner, name)
-	commits, err := s.db.GetCommits(fullName, 50, 0)
-	count, err := s.db.GetCommitCount(fullName)
+	fullName := repositoryFullName(c)
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	var commits []*models.Commit
+	var count int
+	var err error
+	if branch := c.Query("branch"); branch != "" {
+		commits, err = s.db.GetBranchCommits(fullName, branch, limit, offset)
+		if err == nil {
+			count, err = s.db.GetBranchCommitCount(fullName, branch)
+		}
+	} else {
+		commits, err = s.db.GetCommits(fullName, limit, offset)
+		if err == nil {
+			count, err = s.db.GetCommitCount(fullName)
+		}
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":   "Failed to get repositories",
+			"error":   "Failed to get commits",
 			"details": err.Error(),
 		})
 		return
@@ -99,6 +213,315 @@ func (s *HTTPServer) getCommits(c *gin.Context) {
 	})
 }
 
+func (s *HTTPServer) getCommitDetail(c *gin.Context) {
+	fullName := repositoryFullName(c)
+
+	detail, err := s.db.GetCommitDetail(fullName, c.Param("sha"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get commit",
+			"details": err.Error(),
+		})
+		return
+	}
+	if detail == nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Commit not found",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, detail)
+}
+
+func (s *HTTPServer) getReleases(c *gin.Context) {
+	fullName := repositoryFullName(c)
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	releases, err := s.db.GetReleases(fullName, limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get releases",
+			"details": err.Error(),
+		})
+		return
+	}
+	latest, err := s.db.GetLatestRelease(fullName)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get releases",
+			"details": err.Error(),
+		})
+		return
+	}
+	count, err := s.db.GetReleaseCount(fullName)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get releases",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"releases": releases,
+		"latest":   latest,
+		"total":    count,
+	})
+}
+
+func (s *HTTPServer) getBranches(c *gin.Context) {
+	fullName := repositoryFullName(c)
+
+	branches, err := s.db.GetBranches(fullName)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get branches",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"branches": branches,
+		"total":    len(branches),
+	})
+}
+
+func (s *HTTPServer) getLanguages(c *gin.Context) {
+	fullName := repositoryFullName(c)
+
+	languages, err := s.db.GetLanguages(fullName)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get languages",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"languages": languages,
+		"total":     len(languages),
+	})
+}
+
+func (s *HTTPServer) getChurnStats(c *gin.Context) {
+	fullName := repositoryFullName(c)
+
+	since, err := services.ParseSince(c.Query("since"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid since parameter",
+			"details": err.Error(),
+		})
+		return
+	}
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	stats, err := s.db.GetChurnStats(fullName, since, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get churn statistics",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, stats)
+}
+
+func (s *HTTPServer) getReadme(c *gin.Context) {
+	fullName := repositoryFullName(c)
+
+	readme, err := s.db.GetReadme(fullName)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get README",
+			"details": err.Error(),
+		})
+		return
+	}
+	if readme == nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "README not found",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, readme)
+}
+
+func (s *HTTPServer) getMetrics(c *gin.Context) {
+	fullName := repositoryFullName(c)
+
+	from, to, err := services.ParseDateRange(c.Query("from"), c.Query("to"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid date range",
+			"details": err.Error(),
+		})
+		return
+	}
+	interval := c.DefaultQuery("interval", "day")
+
+	points, err := s.db.GetMetricsSeries(fullName, from, to, interval)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Failed to get metrics",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"repository": fullName,
+		"interval":   interval,
+		"from":       from,
+		"to":         to,
+		"series":     points,
+	})
+}
+
+func (s *HTTPServer) getLanguageTotals(c *gin.Context) {
+	languages, err := s.db.GetLanguageTotals(repositoryOwner(c))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get language totals",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"languages": languages,
+		"total":     len(languages),
+	})
+}
+
+// issueFilter reads the issue and pull request filters of a request. Without
+// owner and name all repositories are searched.
+func issueFilter(c *gin.Context) models.IssueFilter {
+	filter := models.IssueFilter{
+		State:  c.Query("state"),
+		Label:  c.Query("label"),
+		Author: c.Query("author"),
+	}
+	if c.Param("owner") != "" && c.Param("name") != "" {
+		filter.Repository = repositoryFullName(c)
+	}
+	filter.Limit, _ = strconv.Atoi(c.DefaultQuery("limit", "50"))
+	filter.Offset, _ = strconv.Atoi(c.DefaultQuery("offset", "0"))
+	return filter
+}
+
+func (s *HTTPServer) getIssues(c *gin.Context) {
+	filter := issueFilter(c)
+	if filter.State == "merged" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Issues cannot be filtered by merged state",
+		})
+		return
+	}
+
+	issues, total, err := s.db.GetIssues(filter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get issues",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"issues": issues,
+		"total":  total,
+	})
+}
+
+func (s *HTTPServer) getPullRequests(c *gin.Context) {
+	pulls, total, err := s.db.GetPullRequests(issueFilter(c))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get pull requests",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"pull_requests": pulls,
+		"total":         total,
+	})
+}
+
+func (s *HTTPServer) getWorkflows(c *gin.Context) {
+	fullName := repositoryFullName(c)
+	days, _ := strconv.Atoi(c.DefaultQuery("days", "30"))
+	since := services.FailureRateSince(days)
+
+	workflows, err := s.db.GetWorkflows(fullName)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get workflows",
+			"details": err.Error(),
+		})
+		return
+	}
+	rates, err := s.db.GetFailureRates(fullName, since)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get failure rates",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"workflows":     workflows,
+		"failure_rates": rates,
+		"since":         since,
+	})
+}
+
+func (s *HTTPServer) getActionsSummary(c *gin.Context) {
+	days, _ := strconv.Atoi(c.DefaultQuery("days", "30"))
+	since := services.FailureRateSince(days)
+
+	rates, err := s.db.GetFailureRates("", since)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get failure rates",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"repositories": rates,
+		"since":        since,
+	})
+}
+
+func (s *HTTPServer) getDiscoveredRepositories(c *gin.Context) {
+	disappeared := c.Query("disappeared") == "true"
+
+	repos, err := s.db.GetDiscoveredRepositories(disappeared)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get discovered repositories",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"repositories": repos,
+		"total":        len(repos),
+	})
+}
+
 type SyncRequest struct {
 	RepositoryURLs []string `json:"repository_urls"`
 }
@@ -106,9 +529,8 @@ type SyncRequest struct {
 func (s *HTTPServer) syncRepositories(c *gin.Context) {
 	var req SyncRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		// If no body provided, use config repositories
-		cfg := config.GetConfig()
-		req.RepositoryURLs = cfg.Github.Repositories
+		// If no body provided, use the configured and discovered repositories
+		req.RepositoryURLs = s.githubService.ResolveRepositories(c.Request.Context(), s.db)
 	}
 
 	if len(req.RepositoryURLs) == 0 {
@@ -118,25 +540,24 @@ func (s *HTTPServer) syncRepositories(c *gin.Context) {
 		return
 	}
 
-	syncedCount, err := s.githubService.SyncRepositories(req.RepositoryURLs, s.db)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":   "Failed to sync repositories",
-			"details": err.Error(),
-		})
+	task := services.RepositoriesTask(req.RepositoryURLs)
+	if s.startAsync(c, task) {
 		return
 	}
-
-	c.JSON(http.StatusOK, gin.H{
-		"message":      fmt.Sprintf("Successfully synced %d repositories", syncedCount),
-		"synced_count": syncedCount,
+	job, report := s.jobs.Run(services.TriggerHTTP, task)
+
+	c.JSON(syncReportStatus(report), gin.H{
+		"message":      fmt.Sprintf("Successfully synced %d repositories", report.Succeeded),
+		"job_id":       job.ID,
+		"status":       report.Status,
+		"synced_count": report.Succeeded,
+		"failed_count": report.Failed,
+		"results":      report.Results,
 	})
 }
 
 func (s *HTTPServer) syncCommits(c *gin.Context) {
-	owner := c.Param("owner")
-	name := c.Param("name")
-	fullName := fmt.Sprintf("%s/%s", owner, name)
+	fullName := repositoryFullName(c)
 
 	if fullName == "" {
 		c.JSON(http.StatusBadRequest, gin.H{
@@ -145,27 +566,35 @@ func (s *HTTPServer) syncCommits(c *gin.Context) {
 		return
 	}
 
-	syncedCount, err := s.githubService.SyncCommits(fullName, 50, s.db)
-	if err != nil {
+	task := services.CommitsTask([]string{fullName}, 50)
+	if s.startAsync(c, task) {
+		return
+	}
+	job, report := s.jobs.Run(services.TriggerHTTP, task)
+	result := report.Results[0]
+	if result.Status == services.SyncStatusFailed {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "Failed to sync commits",
-			"details": err.Error(),
+			"details": result.Error,
+			"job_id":  job.ID,
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"message":      fmt.Sprintf("Successfully synced %d commits", syncedCount),
-		"synced_count": syncedCount,
+		"message":      fmt.Sprintf("Successfully synced %d commits", result.Added),
+		"job_id":       job.ID,
+		"synced_count": result.Added,
+		"new_count":    result.Added,
+		"known_count":  result.Unchanged,
 	})
 }
 
 func (s *HTTPServer) syncCommitsAll(c *gin.Context) {
 	var req SyncRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		// If no body provided, use config repositories
-		cfg := config.GetConfig()
-		req.RepositoryURLs = cfg.Github.Repositories
+		// If no body provided, use the configured and discovered repositories
+		req.RepositoryURLs = s.githubService.ResolveRepositories(c.Request.Context(), s.db)
 	}
 
 	if len(req.RepositoryURLs) == 0 {
@@ -175,18 +604,186 @@ func (s *HTTPServer) syncCommitsAll(c *gin.Context) {
 		return
 	}
 
-	syncedCount, err := s.githubService.SyncCommitsAll(req.RepositoryURLs, 50, s.db)
+	task := services.CommitsTask(req.RepositoryURLs, 50)
+	if s.startAsync(c, task) {
+		return
+	}
+	job, report := s.jobs.Run(services.TriggerHTTP, task)
+
+	c.JSON(syncReportStatus(report), gin.H{
+		"message":      fmt.Sprintf("Successfully synced %d commits", report.Added),
+		"job_id":       job.ID,
+		"status":       report.Status,
+		"synced_count": report.Added,
+		"new_count":    report.Added,
+		"known_count":  report.Unchanged,
+		"failed_count": report.Failed,
+		"results":      report.Results,
+	})
+}
+
+// syncReportStatus maps a sync report to an HTTP status code. Partial
+// failures are still a 200 with the failed repositories listed in results;
+// only a run in which every repository failed is a 502.
+func syncReportStatus(report *services.SyncReport) int {
+	if report.Status == services.SyncStatusFailed {
+		return http.StatusBadGateway
+	}
+	return http.StatusOK
+}
+
+// startAsync runs the task in the background when the request asks for it
+// with ?async=true and responds with 202 and the job ID to poll.
+func (s *HTTPServer) startAsync(c *gin.Context, task services.SyncTask) bool {
+	if async, _ := strconv.ParseBool(c.Query("async")); !async {
+		return false
+	}
+
+	job := s.jobs.Start(services.TriggerHTTP, task)
+	c.JSON(http.StatusAccepted, gin.H{
+		"message": fmt.Sprintf("Started %s sync job", task.Kind),
+		"job_id":  job.ID,
+		"status":  job.Status,
+	})
+	return true
+}
+
+func (s *HTTPServer) backfillCommits(c *gin.Context) {
+	fullName := repositoryFullName(c)
+
+	sinceParam := c.DefaultQuery("since", config.GetConfig().Github.BackfillSince)
+	since, err := services.ParseSince(sinceParam)
 	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid since parameter",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	task := services.BackfillTask(fullName, since)
+	if s.startAsync(c, task) {
+		return
+	}
+	job, report := s.jobs.Run(services.TriggerHTTP, task)
+	result := report.Results[0]
+	if result.Status == services.SyncStatusFailed {
 		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":   "Failed to sync commits",
+			"error":        "Failed to backfill commits",
+			"details":      result.Error,
+			"job_id":       job.ID,
+			"synced_count": result.Added,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":      fmt.Sprintf("Successfully backfilled %d commits", result.Added),
+		"job_id":       job.ID,
+		"synced_count": result.Added,
+	})
+}
+
+func (s *HTTPServer) getSyncJobs(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	jobs, err := s.jobs.ListJobs(limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get sync jobs",
 			"details": err.Error(),
 		})
 		return
 	}
+	count, err := s.db.GetSyncJobCount()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get sync jobs",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"jobs":  jobs,
+		"total": count,
+	})
+}
+
+func (s *HTTPServer) getSyncJob(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid job id",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	job, err := s.jobs.GetJob(id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "Sync job not found",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"job": job,
+	})
+}
+
+func (s *HTTPServer) cancelSyncJob(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid job id",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if err := s.jobs.Cancel(id); err != nil {
+		status := http.StatusNotFound
+		if errors.Is(err, services.ErrJobNotRunning) {
+			status = http.StatusConflict
+		}
+		c.JSON(status, gin.H{
+			"error":   "Failed to cancel sync job",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"message": fmt.Sprintf("Canceling sync job %d", id),
+		"job_id":  id,
+	})
+}
+
+func (s *HTTPServer) getRateLimit(c *gin.Context) {
+	rateLimit, err := s.githubService.FetchRateLimit(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{
+			"error":   "Failed to fetch rate limit",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"rate_limit": rateLimit,
+	})
+}
+
+func (s *HTTPServer) getTokenHealth(c *gin.Context) {
+	health := s.githubService.CheckTokens(c.Request.Context())
 
 	c.JSON(http.StatusOK, gin.H{
-		"message":      fmt.Sprintf("Successfully synced %d commits", syncedCount),
-		"synced_count": syncedCount,
+		"tokens": health,
+		"total":  len(health),
 	})
 }
 
@@ -210,12 +807,12 @@ func (s *HTTPServer) indexPage(c *gin.Context) {
 	})
 }
 
-func StartHTTPServer(db *models.DB, githubService *services.GitHubService) error {
+func StartHTTPServer(db *models.DB, githubService *services.GitHubService, jobs *services.JobManager) error {
 	cfg := config.GetConfig()
 	host := cfg.Server.HTTP.Host
 	port := cfg.Server.HTTP.Port
 
-	server := NewHTTPServer(db, githubService)
+	server := NewHTTPServer(db, githubService, jobs)
 
 	log.Printf("HTTP server starting on %s:%d", host, port)
 	return server.router.Run(fmt.Sprintf("%s:%d", host, port))
@@ -0,0 +1,177 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"twt/models"
+)
+
+type GitHubWorkflow struct {
+	ID      int64  `json:"id"`
+	Name    string `json:"name"`
+	Path    string `json:"path"`
+	State   string `json:"state"`
+	HTMLURL string `json:"html_url"`
+}
+
+type GitHubWorkflowRun struct {
+	ID           int64      `json:"id"`
+	WorkflowID   int64      `json:"workflow_id"`
+	Name         string     `json:"name"`
+	RunNumber    int        `json:"run_number"`
+	Event        string     `json:"event"`
+	HeadBranch   string     `json:"head_branch"`
+	HeadSHA      string     `json:"head_sha"`
+	Status       string     `json:"status"`
+	Conclusion   *string    `json:"conclusion"`
+	HTMLURL      string     `json:"html_url"`
+	CreatedAt    time.Time  `json:"created_at"`
+	RunStartedAt *time.Time `json:"run_started_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+func (g *GitHubService) workflowsAPIURL(repositoryFullName string) string {
+	return fmt.Sprintf("%s/actions/workflows?per_page=%d", g.repositoryAPIURL(repositoryFullName), maxPerPage)
+}
+
+func (g *GitHubService) workflowRunsAPIURL(repositoryFullName string) string {
+	return fmt.Sprintf("%s/actions/runs?per_page=%d", g.repositoryAPIURL(repositoryFullName), maxPerPage)
+}
+
+// GetWorkflows fetches all workflow definitions of a repository, following
+// pagination. It returns ErrNotModified if the list is unchanged since the
+// last call.
+func (g *GitHubService) GetWorkflows(ctx context.Context, repositoryFullName string) ([]*models.Workflow, error) {
+	var workflows []*models.Workflow
+	err := g.getAllPagesCached(ctx, g.workflowsAPIURL(repositoryFullName), func(body io.Reader) error {
+		var page struct {
+			Workflows []GitHubWorkflow `json:"workflows"`
+		}
+		if err := json.NewDecoder(body).Decode(&page); err != nil {
+			return err
+		}
+		for _, gw := range page.Workflows {
+			workflows = append(workflows, &models.Workflow{
+				WorkflowID:         gw.ID,
+				RepositoryFullName: repositoryFullName,
+				Name:               gw.Name,
+				Path:               gw.Path,
+				State:              gw.State,
+				URL:                gw.HTMLURL,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workflows: %w", err)
+	}
+	return workflows, nil
+}
+
+// GetRecentWorkflowRuns fetches the most recent page of workflow runs of a
+// repository. It returns ErrNotModified if the page is unchanged since the
+// last call.
+func (g *GitHubService) GetRecentWorkflowRuns(ctx context.Context, repositoryFullName string) ([]*models.WorkflowRun, error) {
+	apiURL := g.workflowRunsAPIURL(repositoryFullName)
+	resp, err := g.getCached(ctx, apiURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workflow runs: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var page struct {
+		WorkflowRuns []GitHubWorkflowRun `json:"workflow_runs"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		g.dropValidators(apiURL)
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	var runs []*models.WorkflowRun
+	for _, gr := range page.WorkflowRuns {
+		run := &models.WorkflowRun{
+			RunID:              gr.ID,
+			RepositoryFullName: repositoryFullName,
+			WorkflowID:         gr.WorkflowID,
+			Name:               gr.Name,
+			RunNumber:          gr.RunNumber,
+			Event:              gr.Event,
+			Branch:             gr.HeadBranch,
+			HeadSHA:            gr.HeadSHA,
+			Status:             gr.Status,
+			URL:                gr.HTMLURL,
+			CreatedAt:          gr.CreatedAt,
+			StartedAt:          gr.RunStartedAt,
+			UpdatedAt:          gr.UpdatedAt,
+		}
+		if gr.Conclusion != nil {
+			run.Conclusion = *gr.Conclusion
+		}
+		// A completed run is last updated when it finishes
+		if gr.Status == "completed" && gr.RunStartedAt != nil {
+			run.Duration = int(gr.UpdatedAt.Sub(*gr.RunStartedAt).Seconds())
+		}
+		runs = append(runs, run)
+	}
+	return runs, nil
+}
+
+// syncActions replaces the stored workflows of a repository and stores its
+// recent runs. Runs synced earlier are kept, so the history grows with every
+// sync. Repositories without Actions are skipped, as are unchanged lists.
+func (g *GitHubService) syncActions(ctx context.Context, repositoryFullName string, db *models.DB) error {
+	workflows, err := g.GetWorkflows(ctx, repositoryFullName)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return nil
+	case errors.Is(err, ErrNotModified):
+		// Only whether there are workflows matters below
+		if workflows, err = db.GetWorkflows(repositoryFullName); err != nil {
+			return fmt.Errorf("failed to get stored workflows: %w", err)
+		}
+	case err != nil:
+		return err
+	default:
+		if err := db.ReplaceWorkflows(repositoryFullName, workflows); err != nil {
+			g.dropValidators(g.workflowsAPIURL(repositoryFullName))
+			return fmt.Errorf("failed to save workflows: %w", err)
+		}
+	}
+	if len(workflows) == 0 {
+		return nil
+	}
+
+	runs, err := g.GetRecentWorkflowRuns(ctx, repositoryFullName)
+	if errors.Is(err, ErrNotModified) {
+		log.Printf("Workflow runs unchanged: %s\n", repositoryFullName)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := db.SaveWorkflowRuns(runs); err != nil {
+		g.dropValidators(g.workflowRunsAPIURL(repositoryFullName))
+		return fmt.Errorf("failed to save workflow runs: %w", err)
+	}
+
+	log.Printf("Successfully synced %d workflows and %d runs for repository: %s\n", len(workflows), len(runs), repositoryFullName)
+	return nil
+}
+
+// defaultFailureRateDays is the window of failure rates without days.
+const defaultFailureRateDays = 30
+
+// FailureRateSince returns the start of a failure rate window of the given
+// number of days, 30 if days is not positive.
+func FailureRateSince(days int) time.Time {
+	if days <= 0 {
+		days = defaultFailureRateDays
+	}
+	return time.Now().AddDate(0, 0, -days)
+}
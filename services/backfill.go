@@ -0,0 +1,119 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"twt/models"
+)
+
+// maxPerPage is the largest page size accepted by the GitHub API.
+const maxPerPage = 100
+
+// ParseSince parses a date ("2006-01-02") or RFC3339 timestamp. An empty
+// string yields the zero time, meaning no lower bound.
+func ParseSince(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q: expected YYYY-MM-DD or RFC3339", s)
+	}
+	return t, nil
+}
+
+// BackfillCommits walks the full commit history of a repository page by page
+// until the first commit (or since, if set) is reached. Progress is saved
+// after every page so that an interrupted backfill resumes from the next page
+// instead of starting over. Only repositories on github.com and
+// GitHub Enterprise Server can be backfilled.
+func (g *GitHubService) BackfillCommits(ctx context.Context, repoFullName string, since time.Time, db *models.DB, observe SyncObserver) (int, error) {
+	if gh, ok := g.githubFor(repoFullName); !ok {
+		host, _ := splitRepositoryHost(repoFullName)
+		return 0, fmt.Errorf("backfill is not supported for repositories on %s", host)
+	} else if gh != g {
+		return gh.BackfillCommits(ctx, repoFullName, since, db, observe)
+	}
+
+	repoFullName, err := db.ResolveRepositoryName(repoFullName)
+	if err != nil {
+		return 0, fmt.Errorf("failed to look up repository %s: %w", repoFullName, err)
+	}
+	unlock := g.lockRepository(repoFullName)
+	defer unlock()
+
+	state, err := db.GetCommitBackfill(repoFullName)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load backfill state: %w", err)
+	}
+	if state == nil {
+		state = &models.CommitBackfill{RepositoryFullName: repoFullName}
+	}
+
+	// A completed backfill only needs to continue if the lower bound moved back
+	if state.Completed && !since.Before(state.Since) {
+		log.Printf("Backfill of %s already completed\n", repoFullName)
+		return 0, nil
+	}
+	resume := state.NextURL != "" && since.Equal(state.Since)
+	state.Since = since
+	state.Completed = false
+
+	apiURL := state.NextURL
+	if !resume {
+		query := url.Values{}
+		query.Set("per_page", fmt.Sprint(maxPerPage))
+		if state.LastSHA != "" {
+			// The lower bound changed, continue below the oldest commit stored so far
+			query.Set("sha", state.LastSHA)
+		}
+		if !since.IsZero() {
+			query.Set("since", since.UTC().Format(time.RFC3339))
+		}
+		apiURL = fmt.Sprintf("%s/commits?%s", g.repositoryAPIURL(repoFullName), query.Encode())
+	}
+
+	save := g.withCommitDetails(ctx, db.SaveCommit, db)
+	syncedCount := 0
+	for apiURL != "" {
+		commits, next, err := g.getCommitPage(ctx, apiURL, repoFullName, false)
+		if err != nil {
+			return syncedCount, fmt.Errorf("failed to get commits (page %d): %w", state.Page+1, err)
+		}
+
+		// The progress is only saved once the whole page is stored, a page
+		// with a failed commit is fetched again on resume
+		saved := 0
+		for _, commit := range commits {
+			if err := save(commit); err != nil {
+				observe.commitsSaved(repoFullName, saved)
+				return syncedCount + saved, fmt.Errorf("failed to save commit %s: %w", commit.SHA, err)
+			}
+			saved++
+		}
+		syncedCount += saved
+		observe.commitsSaved(repoFullName, saved)
+
+		state.Page++
+		if len(commits) > 0 {
+			state.LastSHA = commits[len(commits)-1].SHA
+		}
+		state.NextURL = next
+		state.Completed = next == ""
+		if err := db.SaveCommitBackfill(state); err != nil {
+			return syncedCount, fmt.Errorf("failed to save backfill state: %w", err)
+		}
+
+		apiURL = next
+	}
+
+	log.Printf("Successfully backfilled %d commits for repository: %s (%d pages)\n", syncedCount, repoFullName, state.Page)
+	return syncedCount, nil
+}
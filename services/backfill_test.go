@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// pagedCommits serves the commit history in pages of two, linked by page
+// number like the GitHub API, and records the requested pages.
+type pagedCommits struct {
+	pages [][]testCommit
+
+	mu        sync.Mutex
+	requested []string
+}
+
+func (p *pagedCommits) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page == 0 {
+		page = 1
+	}
+	p.mu.Lock()
+	p.requested = append(p.requested, strconv.Itoa(page))
+	p.mu.Unlock()
+
+	if page < len(p.pages) {
+		w.Header().Set("Link", fmt.Sprintf(`<http://%s%s?per_page=100&page=%d>; rel="next"`, r.Host, r.URL.Path, page+1))
+	}
+	writeCommits(w, p.pages[page-1])
+}
+
+func (p *pagedCommits) takeRequested() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	requested := strings.Join(p.requested, ",")
+	p.requested = nil
+	return requested
+}
+
+func newPagedCommits() *pagedCommits {
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 12, 0, 0, 0, time.UTC) }
+	return &pagedCommits{pages: [][]testCommit{
+		{{"c6", day(6)}, {"c5", day(5)}},
+		{{"c4", day(4)}, {"c3", day(3)}},
+		{{"c2", day(2)}, {"c1", day(1)}},
+	}}
+}
+
+func TestBackfillCommits(t *testing.T) {
+	api := newPagedCommits()
+	g, db, _ := newTestService(t, api)
+	ctx := context.Background()
+
+	count, err := g.BackfillCommits(ctx, "o/r", time.Time{}, db, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 6 {
+		t.Errorf("count = %d, want 6", count)
+	}
+	if got := api.takeRequested(); got != "1,2,3" {
+		t.Errorf("requested pages %s, want 1,2,3", got)
+	}
+	state, err := db.GetCommitBackfill("o/r")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !state.Completed || state.Page != 3 || state.NextURL != "" || state.LastSHA != "c1" {
+		t.Errorf("state = %+v, want completed after 3 pages", state)
+	}
+
+	// A completed backfill is not repeated
+	if count, err := g.BackfillCommits(ctx, "o/r", time.Time{}, db, nil); err != nil || count != 0 {
+		t.Errorf("second backfill = %d, %v, want 0", count, err)
+	}
+	if got := api.takeRequested(); got != "" {
+		t.Errorf("second backfill requested pages %s", got)
+	}
+}
+
+func TestBackfillCommitsResume(t *testing.T) {
+	api := newPagedCommits()
+	g, db, dbPath := newTestService(t, api)
+	ctx := context.Background()
+
+	// The first attempt fails on the second page
+	restore := failCommitSaves(t, dbPath, "c3")
+	if _, err := g.BackfillCommits(ctx, "o/r", time.Time{}, db, nil); err == nil {
+		t.Fatal("backfill succeeded despite a failing commit")
+	}
+	if got := api.takeRequested(); got != "1,2" {
+		t.Errorf("requested pages %s, want 1,2", got)
+	}
+	state, err := db.GetCommitBackfill("o/r")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state.Completed || state.Page != 1 || !strings.HasSuffix(state.NextURL, "page=2") {
+		t.Errorf("state = %+v, want page 2 next", state)
+	}
+
+	// The resumed backfill starts with the failed page, not the first one
+	restore()
+	if _, err := g.BackfillCommits(ctx, "o/r", time.Time{}, db, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := api.takeRequested(); got != "2,3" {
+		t.Errorf("resumed backfill requested pages %s, want 2,3", got)
+	}
+
+	commits, err := db.GetCommits("o/r", 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"c6", "c5", "c4", "c3", "c2", "c1"}
+	if got := commitSHAs(commits); !reflect.DeepEqual(got, want) {
+		t.Errorf("stored commits %v, want %v", got, want)
+	}
+}
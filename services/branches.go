@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+
+	"twt/models"
+)
+
+type GitHubBranch struct {
+	Name   string `json:"name"`
+	Commit struct {
+		SHA string `json:"sha"`
+	} `json:"commit"`
+	Protected bool `json:"protected"`
+}
+
+func (g *GitHubService) branchesAPIURL(repositoryFullName string) string {
+	return fmt.Sprintf("%s/branches?per_page=%d", g.repositoryAPIURL(repositoryFullName), maxPerPage)
+}
+
+// GetBranches fetches all branches of a repository, following pagination. It
+// returns ErrNotModified if the list is unchanged since the last call.
+func (g *GitHubService) GetBranches(ctx context.Context, repositoryFullName string) ([]*models.Branch, error) {
+	var branches []*models.Branch
+	err := g.getAllPagesCached(ctx, g.branchesAPIURL(repositoryFullName), func(body io.Reader) error {
+		var githubBranches []GitHubBranch
+		if err := json.NewDecoder(body).Decode(&githubBranches); err != nil {
+			return err
+		}
+		for _, gb := range githubBranches {
+			branches = append(branches, &models.Branch{
+				RepositoryFullName: repositoryFullName,
+				Name:               gb.Name,
+				HeadSHA:            gb.Commit.SHA,
+				Protected:          gb.Protected,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get branches: %w", err)
+	}
+	return branches, nil
+}
+
+// syncBranches replaces the stored branch list of a repository with the
+// current list from GitHub unless it is unchanged.
+func (g *GitHubService) syncBranches(ctx context.Context, repositoryFullName string, db *models.DB) error {
+	branches, err := g.GetBranches(ctx, repositoryFullName)
+	if errors.Is(err, ErrNotModified) {
+		log.Printf("Branches unchanged: %s\n", repositoryFullName)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := db.ReplaceBranches(repositoryFullName, branches); err != nil {
+		g.dropValidators(g.branchesAPIURL(repositoryFullName))
+		return fmt.Errorf("failed to save branches: %w", err)
+	}
+
+	log.Printf("Successfully synced %d branches for repository: %s\n", len(branches), repositoryFullName)
+	return nil
+}
@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"twt/models"
+)
+
+func TestSyncCommitsBranches(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 12, 0, 0, 0, time.UTC) }
+	api := &fakeHistory{branches: map[string][]testCommit{
+		"main":    {{"m1", day(1)}},
+		"feature": {{"f1", day(5)}, {"m1", day(1)}},
+	}}
+	g, db, _ := newTestService(t, api)
+	g.branches["o/r"] = []string{"feature"}
+	ctx := context.Background()
+
+	if err := db.SaveRepository(&models.Repository{Name: "r", FullName: "o/r", DefaultBranch: "main"}); err != nil {
+		t.Fatal(err)
+	}
+	if report, _ := g.SyncCommitsAll(ctx, []string{"o/r"}, 10, db, nil); report.Failed != 0 {
+		t.Fatalf("first sync failed: %+v", report.Results[0])
+	}
+
+	// A commit older than the newest feature commit lands on main, e.g. a
+	// rebased branch. The feature commit must not move since past it.
+	api.push("main", testCommit{"m2", day(3)})
+	if report, _ := g.SyncCommitsAll(ctx, []string{"o/r"}, 10, db, nil); report.Failed != 0 {
+		t.Fatalf("second sync failed: %+v", report.Results[0])
+	}
+
+	tests := []struct {
+		branch string
+		want   []string
+	}{
+		{"main", []string{"m2", "m1"}},
+		{"feature", []string{"f1", "m1"}},
+	}
+	for _, tt := range tests {
+		commits, err := db.GetBranchCommits("o/r", tt.branch, 10, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := commitSHAs(commits); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("commits of %s = %v, want %v", tt.branch, got, tt.want)
+		}
+	}
+}
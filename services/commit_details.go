@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+
+	"twt/models"
+)
+
+// GitHubCommitDetail is the response of /commits/:sha. Large commits list
+// their files over several pages.
+type GitHubCommitDetail struct {
+	Parents []struct {
+		SHA string `json:"sha"`
+	} `json:"parents"`
+	Stats struct {
+		Additions int `json:"additions"`
+		Deletions int `json:"deletions"`
+	} `json:"stats"`
+	Files []struct {
+		Filename  string `json:"filename"`
+		Status    string `json:"status"`
+		Additions int    `json:"additions"`
+		Deletions int    `json:"deletions"`
+	} `json:"files"`
+}
+
+// GetCommitDetails fetches the parents, line statistics and changed files of
+// a commit. Only the details are set on the returned commit.
+func (g *GitHubService) GetCommitDetails(ctx context.Context, repositoryFullName, sha string) (*models.CommitDetail, error) {
+	detail := &models.CommitDetail{}
+	detail.SHA = sha
+	detail.RepositoryFullName = repositoryFullName
+
+	apiURL := fmt.Sprintf("%s/commits/%s?per_page=%d", g.repositoryAPIURL(repositoryFullName), sha, maxPerPage)
+	err := g.getAllPages(ctx, apiURL, func(body io.Reader) error {
+		var githubDetail GitHubCommitDetail
+		if err := json.NewDecoder(body).Decode(&githubDetail); err != nil {
+			return err
+		}
+		// Every page repeats the commit, only the files differ
+		if detail.Parents == nil {
+			detail.Additions = githubDetail.Stats.Additions
+			detail.Deletions = githubDetail.Stats.Deletions
+			detail.Parents = []string{}
+			for _, parent := range githubDetail.Parents {
+				detail.Parents = append(detail.Parents, parent.SHA)
+			}
+		}
+		for _, f := range githubDetail.Files {
+			detail.Files = append(detail.Files, &models.CommitFile{
+				Filename:  f.Filename,
+				Status:    f.Status,
+				Additions: f.Additions,
+				Deletions: f.Deletions,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit %s: %w", sha, err)
+	}
+	return detail, nil
+}
+
+// syncCommitDetails fetches and stores the details of a saved commit unless
+// they are stored already.
+func (g *GitHubService) syncCommitDetails(ctx context.Context, repositoryFullName, sha string, db *models.DB) error {
+	known, err := db.HasCommitDetails(sha, repositoryFullName)
+	if err != nil || known {
+		return err
+	}
+
+	detail, err := g.GetCommitDetails(ctx, repositoryFullName, sha)
+	if err != nil {
+		return err
+	}
+	if err := db.SaveCommitDetails(detail); err != nil {
+		return fmt.Errorf("failed to save details of commit %s: %w", sha, err)
+	}
+	return nil
+}
+
+// withCommitDetails wraps a commit save function to also sync the details of
+// the saved commit when github.commit_details is enabled. Failing details are
+// logged and do not fail the save.
+func (g *GitHubService) withCommitDetails(ctx context.Context, save func(*models.Commit) error, db *models.DB) func(*models.Commit) error {
+	if !g.commitDetails {
+		return save
+	}
+	return func(commit *models.Commit) error {
+		if err := save(commit); err != nil {
+			return err
+		}
+		if err := g.syncCommitDetails(ctx, commit.RepositoryFullName, commit.SHA, db); err != nil {
+			log.Printf("Failed to sync details of commit %s: %v\n", commit.SHA, err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,135 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"twt/models"
+)
+
+type GitHubContributor struct {
+	Login         string `json:"login"`
+	AvatarURL     string `json:"avatar_url"`
+	HTMLURL       string `json:"html_url"`
+	Contributions int    `json:"contributions"`
+}
+
+// GitHubContributorStats is an entry of /stats/contributors. Weeks holds the
+// weekly additions (a), deletions (d) and commits (c).
+type GitHubContributorStats struct {
+	Author *struct {
+		Login string `json:"login"`
+	} `json:"author"`
+	Weeks []struct {
+		Additions int `json:"a"`
+		Deletions int `json:"d"`
+		Commits   int `json:"c"`
+	} `json:"weeks"`
+}
+
+func (g *GitHubService) contributorsAPIURL(repositoryFullName string) string {
+	return fmt.Sprintf("%s/contributors?per_page=%d", g.repositoryAPIURL(repositoryFullName), maxPerPage)
+}
+
+// GetContributors fetches all contributors of a repository, following
+// pagination. It returns ErrNotModified if the list is unchanged since the
+// last call.
+func (g *GitHubService) GetContributors(ctx context.Context, repositoryFullName string) ([]*models.Contributor, error) {
+	var contributors []*models.Contributor
+	err := g.getAllPagesCached(ctx, g.contributorsAPIURL(repositoryFullName), func(body io.Reader) error {
+		var githubContributors []GitHubContributor
+		if err := json.NewDecoder(body).Decode(&githubContributors); err != nil {
+			return err
+		}
+		for _, gc := range githubContributors {
+			contributors = append(contributors, &models.Contributor{
+				RepositoryFullName: repositoryFullName,
+				Login:              gc.Login,
+				AvatarURL:          gc.AvatarURL,
+				URL:                gc.HTMLURL,
+				Contributions:      gc.Contributions,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get contributors: %w", err)
+	}
+	return contributors, nil
+}
+
+// GetContributorStats fetches the additions and deletions per contributor. It
+// returns ErrNotReady while GitHub is computing the statistics and
+// ErrNotModified if they are unchanged since the last call.
+func (g *GitHubService) GetContributorStats(ctx context.Context, repositoryFullName string) ([]*models.ContributorStats, error) {
+	apiURL := g.repositoryAPIURL(repositoryFullName) + "/stats/contributors"
+	resp, err := g.getCached(ctx, apiURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNoContent {
+		return nil, nil
+	}
+
+	var githubStats []GitHubContributorStats
+	if err := json.NewDecoder(resp.Body).Decode(&githubStats); err != nil {
+		g.dropValidators(apiURL)
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	var stats []*models.ContributorStats
+	for _, gs := range githubStats {
+		if gs.Author == nil {
+			continue
+		}
+		s := &models.ContributorStats{Login: gs.Author.Login}
+		for _, week := range gs.Weeks {
+			s.Additions += week.Additions
+			s.Deletions += week.Deletions
+		}
+		stats = append(stats, s)
+	}
+	return stats, nil
+}
+
+// syncContributors replaces the stored contributors of a repository unless
+// the list is unchanged. If the statistics are not computed yet the previous
+// additions and deletions are kept and refreshed on the next sync.
+func (g *GitHubService) syncContributors(ctx context.Context, repositoryFullName string, db *models.DB) error {
+	contributors, err := g.GetContributors(ctx, repositoryFullName)
+	switch {
+	case errors.Is(err, ErrNotModified):
+		log.Printf("Contributors unchanged: %s\n", repositoryFullName)
+	case err != nil:
+		return err
+	default:
+		if err := db.ReplaceContributors(repositoryFullName, contributors); err != nil {
+			g.dropValidators(g.contributorsAPIURL(repositoryFullName))
+			return fmt.Errorf("failed to save contributors: %w", err)
+		}
+		log.Printf("Successfully synced %d contributors for repository: %s\n", len(contributors), repositoryFullName)
+	}
+
+	stats, err := g.GetContributorStats(ctx, repositoryFullName)
+	if errors.Is(err, ErrNotModified) {
+		return nil
+	}
+	if errors.Is(err, ErrNotReady) {
+		log.Printf("Contributor statistics of %s are not ready yet\n", repositoryFullName)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get contributor statistics: %w", err)
+	}
+	if err := db.UpdateContributorStats(repositoryFullName, stats); err != nil {
+		g.dropValidators(g.repositoryAPIURL(repositoryFullName) + "/stats/contributors")
+		return fmt.Errorf("failed to save contributor statistics: %w", err)
+	}
+	return nil
+}
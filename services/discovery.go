@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"path"
+	"strings"
+
+	"twt/models"
+)
+
+// ListOwnerRepositories fetches all repositories of an organization, or of a
+// user if user is set, following pagination.
+func (g *GitHubService) ListOwnerRepositories(ctx context.Context, owner string, user bool) ([]*GitHubRepo, error) {
+	apiURL := fmt.Sprintf("%s/orgs/%s/repos?type=all&per_page=%d", g.apiURL, owner, maxPerPage)
+	if user {
+		apiURL = fmt.Sprintf("%s/users/%s/repos?type=owner&per_page=%d", g.apiURL, owner, maxPerPage)
+	}
+
+	var repos []*GitHubRepo
+	err := g.getAllPages(ctx, apiURL, func(body io.Reader) error {
+		var page []*GitHubRepo
+		if err := json.NewDecoder(body).Decode(&page); err != nil {
+			return err
+		}
+		repos = append(repos, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list repositories of %s: %w", owner, err)
+	}
+	return repos, nil
+}
+
+// ResolveRepositories returns the URLs of the configured repositories
+// followed by the repositories discovered through github.discovery. The
+// listings are recorded so that repositories that disappear are noticed.
+// Owners that cannot be listed are logged and skipped.
+func (g *GitHubService) ResolveRepositories(ctx context.Context, db *models.DB) []string {
+	repoURLs := append([]string(nil), g.repoURLs...)
+	seen := make(map[string]bool)
+	for _, repoURL := range repoURLs {
+		seen[strings.ToLower(g.repositoryName(repoURL))] = true
+	}
+
+	type owner struct {
+		name string
+		user bool
+	}
+	var owners []owner
+	for _, org := range g.discovery.Organizations {
+		owners = append(owners, owner{name: org})
+	}
+	for _, user := range g.discovery.Users {
+		owners = append(owners, owner{name: user, user: true})
+	}
+
+	host := g.host
+	if host == "" {
+		host = "github.com"
+	}
+	for _, o := range owners {
+		repos, err := g.ListOwnerRepositories(ctx, o.name, o.user)
+		if err != nil {
+			log.Printf("Failed to discover repositories: %v\n", err)
+			continue
+		}
+
+		listed := make([]*models.DiscoveredRepository, 0, len(repos))
+		for _, repo := range repos {
+			listed = append(listed, &models.DiscoveredRepository{
+				FullName: repo.FullName,
+				URL:      repo.HTMLURL,
+				Archived: repo.Archived,
+				Fork:     repo.Fork,
+			})
+
+			key := strings.ToLower(repo.FullName)
+			if seen[key] || !g.discovers(repo) {
+				continue
+			}
+			seen[key] = true
+			repoURLs = append(repoURLs, repo.HTMLURL)
+		}
+
+		disappeared, err := db.RecordDiscovery(host, o.name, listed)
+		if err != nil {
+			log.Printf("Failed to record discovered repositories of %s: %v\n", o.name, err)
+			continue
+		}
+		for _, fullName := range disappeared {
+			log.Printf("Repository disappeared from %s: %s\n", o.name, fullName)
+		}
+	}
+
+	return repoURLs
+}
+
+// discovers reports whether a listed repository passes the discovery filters.
+func (g *GitHubService) discovers(repo *GitHubRepo) bool {
+	if g.discovery.SkipArchived && repo.Archived {
+		return false
+	}
+	if g.discovery.SkipForks && repo.Fork {
+		return false
+	}
+	if len(g.discovery.Include) > 0 && !matchesAny(repo.FullName, g.discovery.Include) {
+		return false
+	}
+	return !matchesAny(repo.FullName, g.discovery.Exclude)
+}
+
+// matchesAny reports whether fullName matches one of the glob patterns.
+// Patterns without a slash are matched against the repository name only.
+func matchesAny(fullName string, patterns []string) bool {
+	name := fullName[strings.Index(fullName, "/")+1:]
+	for _, pattern := range patterns {
+		subject := fullName
+		if !strings.Contains(pattern, "/") {
+			subject = name
+		}
+		if ok, _ := path.Match(pattern, subject); ok {
+			return true
+		}
+	}
+	return false
+}
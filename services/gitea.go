@@ -0,0 +1,134 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"twt/models"
+)
+
+// GiteaProvider syncs repositories from a Gitea or Forgejo instance. Their
+// commit and release responses match the GitHub API.
+type GiteaProvider struct {
+	host string
+	api  *apiClient
+}
+
+type GiteaRepo struct {
+	Name            string    `json:"name"`
+	FullName        string    `json:"full_name"`
+	Description     string    `json:"description"`
+	HTMLURL         string    `json:"html_url"`
+	Website         string    `json:"website"`
+	Language        string    `json:"language"`
+	Topics          []string  `json:"topics"`
+	DefaultBranch   string    `json:"default_branch"`
+	Archived        bool      `json:"archived"`
+	Fork            bool      `json:"fork"`
+	Private         bool      `json:"private"`
+	Stars           int       `json:"stars_count"`
+	Forks           int       `json:"forks_count"`
+	Watchers        int       `json:"watchers_count"`
+	OpenIssuesCount int       `json:"open_issues_count"`
+	Size            int       `json:"size"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// giteaPageSize is the default maximum page size of Gitea.
+const giteaPageSize = 50
+
+// NewGiteaProvider creates a provider for the Gitea or Forgejo instance at
+// host with the given API base URL, e.g. https://gitea.com/api/v1,
+// authenticating with an access token if set.
+func NewGiteaProvider(host, apiURL, token string, client *http.Client) *GiteaProvider {
+	if token != "" {
+		token = "token " + token
+	}
+	return &GiteaProvider{
+		host: host,
+		api: &apiClient{
+			name:    "Gitea",
+			baseURL: apiURL,
+			header:  "Authorization",
+			token:   token,
+			client:  client,
+		},
+	}
+}
+
+func (p *GiteaProvider) repoAPIURL(fullName string) string {
+	_, repoPath := splitRepositoryHost(fullName)
+	return fmt.Sprintf("%s/repos/%s", p.api.baseURL, repoPath)
+}
+
+func (p *GiteaProvider) GetRepositoryInfo(ctx context.Context, fullName string) (*models.Repository, error) {
+	var giteaRepo GiteaRepo
+	if err := p.api.getJSON(ctx, p.repoAPIURL(fullName), &giteaRepo); err != nil {
+		return nil, err
+	}
+
+	return &models.Repository{
+		Name:          giteaRepo.Name,
+		FullName:      p.host + "/" + giteaRepo.FullName,
+		Description:   giteaRepo.Description,
+		URL:           giteaRepo.HTMLURL,
+		Homepage:      giteaRepo.Website,
+		Language:      giteaRepo.Language,
+		Topics:        giteaRepo.Topics,
+		DefaultBranch: giteaRepo.DefaultBranch,
+		Archived:      giteaRepo.Archived,
+		Fork:          giteaRepo.Fork,
+		Private:       giteaRepo.Private,
+		Stars:         giteaRepo.Stars,
+		Forks:         giteaRepo.Forks,
+		Watchers:      giteaRepo.Watchers,
+		OpenIssues:    giteaRepo.OpenIssuesCount,
+		Size:          giteaRepo.Size,
+		CreatedAt:     giteaRepo.CreatedAt,
+		UpdatedAt:     giteaRepo.UpdatedAt,
+	}, nil
+}
+
+func (p *GiteaProvider) GetCommits(ctx context.Context, fullName string, limit int) ([]*models.Commit, error) {
+	if limit <= 0 {
+		limit = 50 // default limit
+	}
+	// Skip the per-commit stats, verification and file lists
+	apiURL := fmt.Sprintf("%s/commits?limit=%d&stat=false&verification=false&files=false", p.repoAPIURL(fullName), limit)
+
+	var giteaCommits []GitHubCommit
+	if err := p.api.getJSON(ctx, apiURL, &giteaCommits); err != nil {
+		return nil, err
+	}
+
+	var commits []*models.Commit
+	for _, gc := range giteaCommits {
+		commits = append(commits, gc.toCommit(fullName))
+	}
+	return commits, nil
+}
+
+func (p *GiteaProvider) GetReleases(ctx context.Context, fullName string) ([]*models.Release, error) {
+	apiURL := fmt.Sprintf("%s/releases?limit=%d", p.repoAPIURL(fullName), giteaPageSize)
+
+	var releases []*models.Release
+	err := p.api.getAllPages(ctx, apiURL, func(body io.Reader) error {
+		var giteaReleases []GitHubRelease
+		if err := json.NewDecoder(body).Decode(&giteaReleases); err != nil {
+			return err
+		}
+		for _, gr := range giteaReleases {
+			releases = append(releases, gr.toRelease(fullName))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get releases: %w", err)
+	}
+	return releases, nil
+}
@@ -1,33 +1,67 @@
 package services
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
+	"net/url"
+	"path"
 	"strings"
+	"sync"
 	"time"
 
+	"twt/config"
 	"twt/models"
 )
 
 type GitHubService struct {
-	token  string
-	client *http.Client
+	tokens        *tokenPool // personal access tokens
+	app           *appAuth   // GitHub App, nil if not configured
+	apiURL        string     // REST API base URL, e.g. https://api.github.com
+	host          string     // web host of a GitHub Enterprise Server, empty for github.com
+	client        *http.Client
+	cache         *models.DB // ETag/Last-Modified store for conditional requests
+	rate          *rateLimiter
+	maxRetries    int
+	concurrency   int
+	branches      map[string][]string // repository full name -> extra branches to sync
+	commitDetails bool                // fetch /commits/:sha for every new commit
+	repoURLs      []string            // configured repositories
+	discovery     config.DiscoveryConfig
+	providers     map[string]Provider // host -> provider of repositories outside github.com
+	locks         sync.Map            // repository full name -> *sync.Mutex
 }
 
 type GitHubRepo struct {
-	ID          int       `json:"id"`
-	Name        string    `json:"name"`
-	FullName    string    `json:"full_name"`
-	Description *string   `json:"description"`
-	HTMLURL     string    `json:"html_url"`
-	Language    *string   `json:"language"`
-	Stars       int       `json:"stargazers_count"`
-	Forks       int       `json:"forks_count"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          int      `json:"id"`
+	Name        string   `json:"name"`
+	FullName    string   `json:"full_name"`
+	Description *string  `json:"description"`
+	HTMLURL     string   `json:"html_url"`
+	Homepage    *string  `json:"homepage"`
+	Language    *string  `json:"language"`
+	Topics      []string `json:"topics"`
+	License     *struct {
+		SPDXID string `json:"spdx_id"`
+	} `json:"license"`
+	DefaultBranch string `json:"default_branch"`
+	Archived      bool   `json:"archived"`
+	Disabled      bool   `json:"disabled"`
+	Fork          bool   `json:"fork"`
+	Private       bool   `json:"private"`
+	Stars         int    `json:"stargazers_count"`
+	Forks         int    `json:"forks_count"`
+	// watchers_count mirrors the stars, subscribers are the actual watchers
+	Watchers   int        `json:"subscribers_count"`
+	OpenIssues int        `json:"open_issues_count"`
+	Size       int        `json:"size"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+	PushedAt   *time.Time `json:"pushed_at"`
 }
 
 type GitHubCommit struct {
@@ -42,52 +76,323 @@ type GitHubCommit struct {
 	} `json:"commit"`
 }
 
-func NewGitHubService(token string) *GitHubService {
-	return &GitHubService{
-		token:  token,
-		client: &http.Client{Timeout: 30 * time.Second},
+// toCommit converts a commit of the GitHub (or Gitea) API to our model.
+func (gc GitHubCommit) toCommit(repositoryFullName string) *models.Commit {
+	return &models.Commit{
+		SHA:                gc.SHA,
+		Message:            gc.Commit.Message,
+		AuthorName:         gc.Commit.Author.Name,
+		AuthorEmail:        gc.Commit.Author.Email,
+		CommitDate:         gc.Commit.Author.Date,
+		RepositoryFullName: repositoryFullName,
 	}
 }
 
-func (g *GitHubService) GetRepositoryInfo(repoURL string) (*models.Repository, error) {
-	// Extract owner and repo name from URL
-	// e.g., https://github.com/gin-gonic/gin -> gin-gonic/gin
-	parts := strings.Split(strings.TrimPrefix(repoURL, "https://github.com/"), "/")
-	if len(parts) < 2 {
-		return nil, fmt.Errorf("invalid repository URL: %s", repoURL)
+func NewGitHubService(cfg config.GithubConfig, providerConfigs []config.ProviderConfig, cache *models.DB) (*GitHubService, error) {
+	maxWait := 15 * time.Minute
+	if cfg.MaxRateLimitWait != "" {
+		d, err := time.ParseDuration(cfg.MaxRateLimitWait)
+		if err != nil {
+			return nil, fmt.Errorf("invalid max_rate_limit_wait %q: %w", cfg.MaxRateLimitWait, err)
+		}
+		maxWait = d
+	}
+
+	maxRetries := cfg.MaxRetries
+	if maxRetries <= 0 {
+		maxRetries = 3
+	}
+
+	concurrency := cfg.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultConcurrency
 	}
 
-	owner := parts[0]
-	repoName := parts[1]
-	fullName := fmt.Sprintf("%s/%s", owner, repoName)
+	for _, pattern := range append(cfg.Discovery.Include, cfg.Discovery.Exclude...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid discovery pattern %q: %w", pattern, err)
+		}
+	}
+
+	apiURL := strings.TrimSuffix(cfg.APIURL, "/")
+	if apiURL == "" {
+		apiURL = "https://api.github.com"
+	}
+
+	g := &GitHubService{
+		tokens:        newTokenPool(append([]string{cfg.Token}, cfg.Tokens...), maxWait),
+		apiURL:        apiURL,
+		client:        &http.Client{Timeout: 30 * time.Second},
+		cache:         cache,
+		rate:          &rateLimiter{maxWait: maxWait},
+		maxRetries:    maxRetries,
+		concurrency:   concurrency,
+		branches:      make(map[string][]string),
+		commitDetails: cfg.CommitDetails,
+		repoURLs:      cfg.Repositories,
+		discovery:     cfg.Discovery,
+	}
+
+	if cfg.App.AppID != 0 {
+		app, err := newAppAuth(cfg.App, apiURL, g.client, maxWait)
+		if err != nil {
+			return nil, err
+		}
+		g.app = app
+	}
+
+	providers, err := g.newProviders(providerConfigs)
+	if err != nil {
+		return nil, err
+	}
+	g.providers = providers
+
+	// Nested GitLab paths can only be parsed once the providers are known
+	for _, b := range cfg.Branches {
+		fullName, err := g.parseRepositoryURL(b.Repository)
+		if err != nil {
+			return nil, fmt.Errorf("invalid branches entry: %w", err)
+		}
+		g.branches[fullName] = append(g.branches[fullName], b.Branches...)
+	}
+	return g, nil
+}
+
+// parseRepositoryURL extracts the full name from a repository URL
+// e.g., https://github.com/gin-gonic/gin -> gin-gonic/gin
+// Repositories on other hosts are qualified with the host. Only GitLab nests
+// projects in groups, e.g. https://gitlab.com/group/sub/project ->
+// gitlab.com/group/sub/project; on other hosts the path ends after the
+// repository name, e.g. https://ghe.example.com/org/repo/tree/main ->
+// ghe.example.com/org/repo
+func (g *GitHubService) parseRepositoryURL(repoURL string) (string, error) {
+	trimmed := strings.TrimPrefix(strings.TrimPrefix(repoURL, "https://"), "http://")
+	trimmed = strings.TrimSuffix(strings.TrimPrefix(trimmed, "github.com/"), ".git")
+	parts := strings.Split(trimmed, "/")
+
+	host := ""
+	if isHost(parts[0]) {
+		host, parts = strings.ToLower(parts[0]), parts[1:]
+	}
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", fmt.Errorf("invalid repository URL: %s", repoURL)
+	}
+	if host == "" {
+		return fmt.Sprintf("%s/%s", parts[0], parts[1]), nil
+	}
+	if _, ok := g.providers[host].(*GitLabProvider); !ok {
+		return fmt.Sprintf("%s/%s/%s", host, parts[0], parts[1]), nil
+	}
+
+	// GitLab separates the project path from its pages with "-"
+	n := 2
+	for n < len(parts) && parts[n] != "" && parts[n] != "-" {
+		n++
+	}
+	return host + "/" + strings.Join(parts[:n], "/"), nil
+}
+
+// isHost reports whether the first segment of a repository URL or full name
+// is a host. GitHub owners cannot contain dots or colons.
+func isHost(segment string) bool {
+	return strings.ContainsAny(segment, ".:")
+}
+
+// splitRepositoryHost splits a full name into its host, empty for github.com,
+// and the repository path on that host.
+func splitRepositoryHost(fullName string) (host, repoPath string) {
+	if i := strings.Index(fullName, "/"); i > 0 && isHost(fullName[:i]) {
+		return fullName[:i], fullName[i+1:]
+	}
+	return "", fullName
+}
+
+// repositoryName returns the owner/name of a repository URL or full name,
+// falling back to the input if it cannot be parsed.
+func (g *GitHubService) repositoryName(repo string) string {
+	if fullName, err := g.parseRepositoryURL(repo); err == nil {
+		return fullName
+	}
+	return repo
+}
+
+// lockRepository serializes sync runs of the same repository so that
+// scheduled and manually triggered syncs never overlap.
+func (g *GitHubService) lockRepository(fullName string) func() {
+	mu, _ := g.locks.LoadOrStore(strings.ToLower(fullName), &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+	return mu.(*sync.Mutex).Unlock
+}
+
+// ErrNotModified is returned by conditional requests when GitHub answers
+// 304, i.e. the resource is unchanged since the cached response.
+var ErrNotModified = errors.New("not modified")
+
+// ErrNotReady is returned when GitHub answers 202 because it is still
+// computing the response, e.g. for repository statistics. Retry later.
+var ErrNotReady = errors.New("GitHub is still computing the response")
+
+// ErrNotFound is returned when GitHub answers 404, e.g. for a repository
+// without a README.
+var ErrNotFound = errors.New("not found")
 
-	// GitHub API URL
-	apiURL := fmt.Sprintf("https://api.github.com/repos/%s", fullName)
+// jsonMediaType is the media type of regular GitHub API responses.
+const jsonMediaType = "application/vnd.github.v3+json"
 
+// get performs an authenticated GET request against the GitHub API. The caller
+// must close the response body.
+func (g *GitHubService) get(ctx context.Context, apiURL string) (*http.Response, error) {
+	return g.fetch(ctx, apiURL, jsonMediaType, false)
+}
+
+// getCached is like get but sends the ETag/Last-Modified stored for apiURL and
+// returns ErrNotModified on a 304 response. Such responses do not count
+// against the rate limit.
+func (g *GitHubService) getCached(ctx context.Context, apiURL string) (*http.Response, error) {
+	return g.fetch(ctx, apiURL, jsonMediaType, true)
+}
+
+func (g *GitHubService) fetch(ctx context.Context, apiURL, mediaType string, conditional bool) (*http.Response, error) {
 	// Create request
-	req, err := http.NewRequest("GET", apiURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	// Add authorization header if token is provided
-	if g.token != "" && g.token != "your_github_token_here" {
-		req.Header.Set("Authorization", fmt.Sprintf("token %s", g.token))
+	req.Header.Set("Accept", mediaType)
+
+	conditional = conditional && g.cache != nil
+	if conditional {
+		entry, err := g.cache.GetHTTPCache(cacheKey(apiURL))
+		if err != nil {
+			log.Printf("Failed to load cache entry for %s: %v\n", apiURL, err)
+		} else if entry != nil {
+			if entry.ETag != "" {
+				req.Header.Set("If-None-Match", entry.ETag)
+			}
+			if entry.LastModified != "" {
+				req.Header.Set("If-Modified-Since", entry.LastModified)
+			}
+		}
 	}
-	req.Header.Set("Accept", "application/vnd.github.v3+json")
 
 	// Make request
-	resp, err := g.client.Do(req)
+	resp, err := g.do(req, g.credential(ctx, apiURL))
 	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %w", err)
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
+	if resp.StatusCode == http.StatusNotModified {
+		resp.Body.Close()
+		return nil, ErrNotModified
+	}
+	if resp.StatusCode == http.StatusAccepted {
+		resp.Body.Close()
+		return nil, ErrNotReady
+	}
+
+	// Lists of empty repositories are answered with 204
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		defer resp.Body.Close()
 		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%w: GitHub API error: %d - %s", ErrNotFound, resp.StatusCode, string(body))
+		}
 		return nil, fmt.Errorf("GitHub API error: %d - %s", resp.StatusCode, string(body))
 	}
 
+	if conditional {
+		entry := &models.HTTPCacheEntry{
+			URL:          cacheKey(apiURL),
+			ETag:         resp.Header.Get("ETag"),
+			LastModified: resp.Header.Get("Last-Modified"),
+		}
+		if entry.ETag != "" || entry.LastModified != "" {
+			if err := g.cache.SaveHTTPCache(entry); err != nil {
+				log.Printf("Failed to save cache entry for %s: %v\n", apiURL, err)
+			}
+		}
+	}
+
+	return resp, nil
+}
+
+// cacheKey returns the key under which the validators of apiURL are stored.
+// The since parameter of incremental lists changes with every sync; keeping
+// it would add a row per sync that is never used again.
+func cacheKey(apiURL string) string {
+	u, err := url.Parse(apiURL)
+	if err != nil {
+		return apiURL
+	}
+	query := u.Query()
+	if !query.Has("since") {
+		return apiURL
+	}
+	query.Del("since")
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// dropValidators deletes the validators stored for apiURL so that the next
+// request is not answered with 304 for data that was never stored.
+func (g *GitHubService) dropValidators(apiURL string) {
+	if g.cache == nil {
+		return
+	}
+	if err := g.cache.DeleteHTTPCache(cacheKey(apiURL)); err != nil {
+		log.Printf("Failed to delete cache entry for %s: %v\n", apiURL, err)
+	}
+}
+
+// nextPageURL returns the rel="next" target of a Link header, or "" on the
+// last page.
+// e.g., <https://api.github.com/repositories/1/commits?page=2>; rel="next", <...>; rel="last"
+func nextPageURL(link string) string {
+	for _, part := range strings.Split(link, ",") {
+		segments := strings.Split(part, ";")
+		if len(segments) < 2 {
+			continue
+		}
+		for _, param := range segments[1:] {
+			if strings.TrimSpace(param) == `rel="next"` {
+				return strings.Trim(strings.TrimSpace(segments[0]), "<>")
+			}
+		}
+	}
+	return ""
+}
+
+// qualify returns the full name under which a repository of the GitHub API is
+// stored, i.e. prefixed with the host for GitHub Enterprise Server.
+func (g *GitHubService) qualify(fullName string) string {
+	if g.host == "" {
+		return fullName
+	}
+	return g.host + "/" + fullName
+}
+
+// repositoryAPIURL returns the API URL of a repository. The host qualifier of
+// GitHub Enterprise repositories is not part of the API path.
+func (g *GitHubService) repositoryAPIURL(fullName string) string {
+	_, repoPath := splitRepositoryHost(fullName)
+	return fmt.Sprintf("%s/repos/%s", g.apiURL, repoPath)
+}
+
+// GetRepositoryInfo fetches repository metadata. It returns ErrNotModified if
+// the repository is unchanged since the last call.
+func (g *GitHubService) GetRepositoryInfo(ctx context.Context, repoURL string) (*models.Repository, error) {
+	fullName, err := g.parseRepositoryURL(repoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := g.getCached(ctx, g.repositoryAPIURL(fullName))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
 	// Parse response
 	var githubRepo GitHubRepo
 	if err := json.NewDecoder(resp.Body).Decode(&githubRepo); err != nil {
@@ -96,13 +401,23 @@ func (g *GitHubService) GetRepositoryInfo(repoURL string) (*models.Repository, e
 
 	// Convert to our model
 	repo := &models.Repository{
-		Name:      githubRepo.Name,
-		FullName:  githubRepo.FullName,
-		URL:       githubRepo.HTMLURL,
-		Stars:     githubRepo.Stars,
-		Forks:     githubRepo.Forks,
-		CreatedAt: githubRepo.CreatedAt,
-		UpdatedAt: githubRepo.UpdatedAt,
+		Name:          githubRepo.Name,
+		FullName:      g.qualify(githubRepo.FullName),
+		URL:           githubRepo.HTMLURL,
+		Topics:        githubRepo.Topics,
+		DefaultBranch: githubRepo.DefaultBranch,
+		Archived:      githubRepo.Archived,
+		Disabled:      githubRepo.Disabled,
+		Fork:          githubRepo.Fork,
+		Private:       githubRepo.Private,
+		Stars:         githubRepo.Stars,
+		Forks:         githubRepo.Forks,
+		Watchers:      githubRepo.Watchers,
+		OpenIssues:    githubRepo.OpenIssues,
+		Size:          githubRepo.Size,
+		CreatedAt:     githubRepo.CreatedAt,
+		UpdatedAt:     githubRepo.UpdatedAt,
+		PushedAt:      githubRepo.PushedAt,
 	}
 
 	if githubRepo.Description != nil {
@@ -113,128 +428,362 @@ func (g *GitHubService) GetRepositoryInfo(repoURL string) (*models.Repository, e
 		repo.Language = *githubRepo.Language
 	}
 
+	if githubRepo.Homepage != nil {
+		repo.Homepage = *githubRepo.Homepage
+	}
+
+	if githubRepo.License != nil {
+		repo.License = githubRepo.License.SPDXID
+	}
+
 	return repo, nil
 }
 
-func (g *GitHubService) GetCommits(repositoryFullName string, limit int) ([]*models.Commit, error) {
+func (g *GitHubService) GetCommits(ctx context.Context, repositoryFullName string, limit int) ([]*models.Commit, error) {
 	if limit <= 0 {
 		limit = 50 // default limit
 	}
 
 	// GitHub API URL for commits
-	apiURL := fmt.Sprintf("https://api.github.com/repos/%s/commits?per_page=%d", repositoryFullName, limit)
+	apiURL := fmt.Sprintf("%s/commits?per_page=%d", g.repositoryAPIURL(repositoryFullName), limit)
 
-	// Create request
-	req, err := http.NewRequest("GET", apiURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	// Add authorization header if token is provided
-	if g.token != "" && g.token != "your_github_token_here" {
-		req.Header.Set("Authorization", fmt.Sprintf("token %s", g.token))
-	}
-	req.Header.Set("Accept", "application/vnd.github.v3+json")
+	commits, _, err := g.getCommitPage(ctx, apiURL, repositoryFullName, false)
+	return commits, err
+}
 
-	// Make request
-	resp, err := g.client.Do(req)
+// getCommitPage fetches a single page of the commit list and returns the URL
+// of the next page, if any. Conditional pages may return ErrNotModified.
+func (g *GitHubService) getCommitPage(ctx context.Context, apiURL, repositoryFullName string, conditional bool) ([]*models.Commit, string, error) {
+	_, repoPath := splitRepositoryHost(repositoryFullName)
+	owner, _, _ := strings.Cut(repoPath, "/")
+	resp, err := g.fetch(withOwner(ctx, owner), apiURL, jsonMediaType, conditional)
 	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %w", err)
+		return nil, "", err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("GitHub API error: %d - %s", resp.StatusCode, string(body))
-	}
-
 	// Parse response
 	var githubCommits []GitHubCommit
 	if err := json.NewDecoder(resp.Body).Decode(&githubCommits); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+		return nil, "", fmt.Errorf("failed to decode response: %w", err)
 	}
 
 	// Convert to our model
 	var commits []*models.Commit
 	for _, gc := range githubCommits {
-		commit := &models.Commit{
-			SHA:                gc.SHA,
-			Message:            gc.Commit.Message,
-			AuthorName:         gc.Commit.Author.Name,
-			AuthorEmail:        gc.Commit.Author.Email,
-			CommitDate:         gc.Commit.Author.Date,
-			RepositoryFullName: repositoryFullName,
-		}
-		commits = append(commits, commit)
+		commits = append(commits, gc.toCommit(repositoryFullName))
 	}
 
-	return commits, nil
+	return commits, nextPageURL(resp.Header.Get("Link")), nil
 }
 
-func (g *GitHubService) SyncCommits(repoFullName string, limit int, db *models.DB) (int, error) {
-	syncedCount := 0
-	commits, err := g.GetCommits(repoFullName, limit)
+// CommitSyncStats counts the commits seen by an incremental sync.
+type CommitSyncStats struct {
+	New   int `json:"new_count"`
+	Known int `json:"known_count"`
+}
+
+func (g *GitHubService) SyncCommits(ctx context.Context, repoFullName string, limit int, db *models.DB) (CommitSyncStats, error) {
+	unlock := g.lockRepository(repoFullName)
+	defer unlock()
+
+	stats, err := g.syncCommits(ctx, repoFullName, "", limit, db, nil)
 	if err != nil {
-		return 0, fmt.Errorf("failed to get commits: %w", err)
+		return stats, err
 	}
 
-	for _, commit := range commits {
-		if err := db.SaveCommit(commit); err != nil {
-			log.Printf("Failed to save commit %s: %v\n", commit.SHA, err)
-			continue
+	log.Printf("Successfully synced %d new commits (%d known) for repository: %s\n", stats.New, stats.Known, repoFullName)
+	return stats, nil
+}
+
+// syncCommits fetches only the commits newer than the latest stored one. The
+// newest stored commit date is used as the since parameter and paging stops
+// as soon as an already stored SHA shows up. Repositories without stored
+// commits get the newest limit commits. An empty branch syncs the default
+// branch; commits are also recorded in commit_branches under the branch name,
+// for the default branch once the repository and its name are stored.
+func (g *GitHubService) syncCommits(ctx context.Context, repoFullName, branch string, limit int, db *models.DB, observe SyncObserver) (CommitSyncStats, error) {
+	var stats CommitSyncStats
+
+	latestDate := func() (time.Time, error) { return db.GetLatestCommitDate(repoFullName) }
+	isKnown := func(sha string) (bool, error) { return db.HasCommit(sha, repoFullName) }
+	save := db.SaveCommit
+	if branch != "" {
+		latestDate = func() (time.Time, error) { return db.GetLatestBranchCommitDate(repoFullName, branch) }
+		isKnown = func(sha string) (bool, error) { return db.HasBranchCommit(sha, repoFullName, branch) }
+		save = func(commit *models.Commit) error { return db.SaveBranchCommit(commit, branch) }
+	} else {
+		defaultBranch, err := db.GetDefaultBranch(repoFullName)
+		if err != nil {
+			return stats, fmt.Errorf("failed to get default branch: %w", err)
+		}
+		// Commits first synced from another branch are recorded for the
+		// default branch as well once they show up there. Newer commits of
+		// other branches must not move since past the default branch.
+		if defaultBranch != "" {
+			latestDate = func() (time.Time, error) { return db.GetLatestBranchCommitDate(repoFullName, defaultBranch) }
+			isKnown = func(sha string) (bool, error) { return db.HasBranchCommit(sha, repoFullName, defaultBranch) }
+			save = func(commit *models.Commit) error { return db.SaveBranchCommit(commit, defaultBranch) }
 		}
-		syncedCount++
 	}
+	save = g.withCommitDetails(ctx, save, db)
 
-	log.Printf("Successfully synced %d commits for repository: %s\n", syncedCount, repoFullName)
-	return syncedCount, nil
-}
-
-func (g *GitHubService) SyncCommitsAll(repoURLs []string, limit int, db *models.DB) (int, error) {
-	var fullNames []string
-	for _, repoURL := range repoURLs {
-		fullNames = append(fullNames, strings.TrimPrefix(repoURL, "https://github.com/"))
+	query := url.Values{}
+	if branch != "" {
+		query.Set("sha", branch)
 	}
 
-	syncedCount := 0
-	for _, fullName := range fullNames {
-		commits, err := g.GetCommits(fullName, limit)
+	latest, err := latestDate()
+	if err != nil {
+		return stats, fmt.Errorf("failed to get latest commit: %w", err)
+	}
+	if latest.IsZero() {
+		if limit <= 0 {
+			limit = 50 // default limit
+		}
+		query.Set("per_page", fmt.Sprint(limit))
+		apiURL := fmt.Sprintf("%s/commits?%s", g.repositoryAPIURL(repoFullName), query.Encode())
+		commits, _, err := g.getCommitPage(ctx, apiURL, repoFullName, false)
 		if err != nil {
-			return 0, fmt.Errorf("failed to get commits: %w", err)
+			return stats, fmt.Errorf("failed to get commits: %w", err)
 		}
-
 		for _, commit := range commits {
-			if err := db.SaveCommit(commit); err != nil {
+			if err := save(commit); err != nil {
 				log.Printf("Failed to save commit %s: %v\n", commit.SHA, err)
 				continue
 			}
-			log.Printf("Successfully saved [%s] commit: %s\n", fullName, commit.SHA)
-			syncedCount++
+			stats.New++
+		}
+		observe.commitsSaved(repoFullName, stats.New)
+		return stats, nil
+	}
+
+	query.Set("per_page", fmt.Sprint(maxPerPage))
+	query.Set("since", latest.UTC().Format(time.RFC3339))
+	firstURL := fmt.Sprintf("%s/commits?%s", g.repositoryAPIURL(repoFullName), query.Encode())
+
+	// Only the first page is conditional: an unchanged first page means no
+	// commits were pushed since the last sync. The pages are collected before
+	// anything is stored, newest first, down to the first known commit.
+	var commits []*models.Commit
+	for page, apiURL := 1, firstURL; apiURL != ""; page++ {
+		pageCommits, next, err := g.getCommitPage(ctx, apiURL, repoFullName, page == 1)
+		if errors.Is(err, ErrNotModified) {
+			return stats, nil
+		}
+		if err != nil {
+			g.dropValidators(firstURL)
+			return stats, fmt.Errorf("failed to get commits: %w", err)
+		}
+
+		reachedKnown := false
+		for _, commit := range pageCommits {
+			known, err := isKnown(commit.SHA)
+			if err != nil {
+				g.dropValidators(firstURL)
+				return stats, fmt.Errorf("failed to check commit %s: %w", commit.SHA, err)
+			}
+			if known {
+				stats.Known++
+				reachedKnown = true
+				continue
+			}
+			commits = append(commits, commit)
+		}
+		if reachedKnown {
+			break
+		}
+		apiURL = next
+	}
+
+	// Store oldest first and stop at the first failure, so the newest stored
+	// date, the since of the next sync, never passes a missing commit
+	for i := len(commits) - 1; i >= 0; i-- {
+		if err := save(commits[i]); err != nil {
+			g.dropValidators(firstURL)
+			observe.commitsSaved(repoFullName, stats.New)
+			return stats, fmt.Errorf("failed to save commit %s: %w", commits[i].SHA, err)
 		}
+		stats.New++
 	}
+	observe.commitsSaved(repoFullName, stats.New)
 
-	log.Printf("Successfully synced %d commits for repository: %d\n", syncedCount, len(repoURLs))
-	return syncedCount, nil
+	return stats, nil
 }
 
-func (g *GitHubService) SyncRepositories(repoURLs []string, db *models.DB) (int, error) {
-	syncedCount := 0
+// SyncCommitsAll incrementally syncs the commits of all repositories, and of
+// the branches configured in github.branches, using the worker pool. Failed
+// repositories are reported but do not abort the run.
+func (g *GitHubService) SyncCommitsAll(ctx context.Context, repoURLs []string, limit int, db *models.DB, observe SyncObserver) (*SyncReport, error) {
+	report := g.runPool(ctx, repoURLs, observe, func(ctx context.Context, repoURL string) *RepoSyncResult {
+		fullName := g.repositoryName(repoURL)
+		result := &RepoSyncResult{Repository: fullName, Status: SyncStatusSuccess}
+		// Commits are stored under the name of the stored repository
+		fullName, err := db.ResolveRepositoryName(fullName)
+		if err != nil {
+			log.Printf("Failed to sync commits of %s: %v\n", result.Repository, err)
+			result.Status = SyncStatusFailed
+			result.Error = err.Error()
+			return result
+		}
+		result.Repository = fullName
+		gh, ok := g.githubFor(fullName)
+		if !ok {
+			return g.syncProviderCommits(ctx, fullName, limit, db, observe)
+		}
 
-	for _, repoURL := range repoURLs {
-		repo, err := g.GetRepositoryInfo(repoURL)
+		unlock := gh.lockRepository(fullName)
+		stats, err := gh.syncCommits(ctx, fullName, "", limit, db, observe)
+		for _, branch := range g.branches[fullName] {
+			if err != nil {
+				break
+			}
+			var branchStats CommitSyncStats
+			branchStats, err = gh.syncCommits(ctx, fullName, branch, limit, db, observe)
+			if err != nil {
+				err = fmt.Errorf("branch %s: %w", branch, err)
+			}
+			stats.New += branchStats.New
+			stats.Known += branchStats.Known
+		}
+		unlock()
 		if err != nil {
-			log.Printf("Failed to sync repository %s: %v\n", repoURL, err)
-			continue
+			log.Printf("Failed to sync commits of %s: %v\n", fullName, err)
+			result.Status = SyncStatusFailed
+			result.Error = err.Error()
+			return result
 		}
 
-		if err := db.SaveRepository(repo); err != nil {
-			log.Printf("Failed to save repository %s: %v\n", repo.FullName, err)
-			continue
+		log.Printf("Successfully synced [%s] %d new commits (%d known)\n", fullName, stats.New, stats.Known)
+		result.Added = stats.New
+		result.Unchanged = stats.Known
+		return result
+	})
+
+	log.Printf("Successfully synced %d new commits for repository: %d/%d\n", report.Added, report.Succeeded, len(repoURLs))
+	return report, nil
+}
+
+// SyncRepositories syncs repository metadata and details (releases, tags,
+// branches, contributors, issues, pull requests, languages, the README and
+// Actions workflows) using the worker pool. Repositories on GitHub Enterprise
+// Server are synced by the service of their host, repositories of other
+// providers only through the Provider interface.
+// Failed repositories are logged and reported but do not abort the run.
+func (g *GitHubService) SyncRepositories(ctx context.Context, repoURLs []string, db *models.DB, observe SyncObserver) (*SyncReport, error) {
+	report := g.runPool(ctx, repoURLs, observe, func(ctx context.Context, repoURL string) *RepoSyncResult {
+		gh, ok := g.githubFor(g.repositoryName(repoURL))
+		if !ok {
+			return g.syncProviderRepository(ctx, repoURL, db)
 		}
+		result := gh.syncRepository(ctx, repoURL, db)
+		if result.Status != SyncStatusFailed {
+			unlock := gh.lockRepository(result.Repository)
+			result.Warnings = gh.syncRepositoryDetails(ctx, result.Repository, db)
+			unlock()
+		}
+		if result.Status == SyncStatusFailed {
+			log.Printf("Failed to sync repository %s: %s\n", repoURL, result.Error)
+		}
+		return result
+	})
 
-		log.Printf("Successfully synced repository: %s\n", repo.FullName)
-		syncedCount++
+	return report, nil
+}
+
+// syncRepositoryDetails syncs the lists stored next to the repository
+// metadata. The steps are independent: a failing endpoint, e.g. Actions or
+// issues disabled for the repository, is logged and returned as a warning
+// while the remaining steps still run.
+func (g *GitHubService) syncRepositoryDetails(ctx context.Context, fullName string, db *models.DB) []string {
+	var warnings []string
+	for _, step := range []struct {
+		name string
+		sync func(context.Context, string, *models.DB) error
+	}{
+		{"releases", g.syncReleases},
+		{"branches", g.syncBranches},
+		{"contributors", g.syncContributors},
+		{"issues", g.syncIssues},
+		{"languages", g.syncLanguages},
+		{"readme", g.syncReadme},
+		{"actions", g.syncActions},
+	} {
+		if ctx.Err() != nil {
+			break
+		}
+		if err := step.sync(ctx, fullName, db); err != nil {
+			log.Printf("Failed to sync %s of %s: %v\n", step.name, fullName, err)
+			warnings = append(warnings, fmt.Sprintf("%s: %v", step.name, err))
+		}
+	}
+	return warnings
+}
+
+func (g *GitHubService) syncRepository(ctx context.Context, repoURL string, db *models.DB) *RepoSyncResult {
+	result := &RepoSyncResult{Repository: repoURL, Status: SyncStatusSuccess}
+	fail := func(err error) *RepoSyncResult {
+		result.Status = SyncStatusFailed
+		result.Error = err.Error()
+		return result
+	}
+
+	urlName, err := g.parseRepositoryURL(repoURL)
+	if err != nil {
+		return fail(err)
+	}
+	result.Repository = urlName
+
+	repo, err := g.GetRepositoryInfo(ctx, repoURL)
+	if errors.Is(err, ErrNotModified) {
+		// The stored name may differ in case from the URL
+		fullName, err := db.ResolveRepositoryName(urlName)
+		if err != nil {
+			return fail(fmt.Errorf("failed to look up repository %s: %w", urlName, err))
+		}
+		exists, err := db.TouchRepository(fullName)
+		if err != nil {
+			return fail(fmt.Errorf("failed to update repository %s: %w", fullName, err))
+		}
+		if exists {
+			log.Printf("Repository unchanged: %s\n", fullName)
+			result.Repository = fullName
+			g.recordMetrics(fullName, db)
+			result.Unchanged = 1
+			return result
+		}
+		// The row is gone but the validators remain, fetch the full response
+		g.dropValidators(g.repositoryAPIURL(urlName))
+		repo, err = g.GetRepositoryInfo(ctx, repoURL)
+	}
+	if err != nil {
+		return fail(err)
+	}
+
+	// Everything is stored under the name returned by the API, which may
+	// differ in case from the URL or be the new name of a renamed repository
+	fullName := repo.FullName
+	result.Repository = fullName
+	unlock := g.lockRepository(fullName)
+	defer unlock()
+
+	exists, err := db.HasRepository(fullName)
+	if err != nil {
+		return fail(err)
+	}
+	if err := db.SaveRepository(repo); err != nil {
+		// Drop the validators so the next sync does not get a 304 for data
+		// that was never stored
+		g.dropValidators(g.repositoryAPIURL(urlName))
+		return fail(fmt.Errorf("failed to save repository %s: %w", fullName, err))
+	}
+	if exists {
+		result.Updated = 1
+	} else {
+		result.Added = 1
 	}
+	g.recordMetrics(fullName, db)
 
-	return syncedCount, nil
+	log.Printf("Successfully synced repository: %s\n", repo.FullName)
+	return result
 }
@@ -0,0 +1,302 @@
+package services
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"twt/config"
+)
+
+// Installation tokens are valid for an hour. They are refreshed a few minutes
+// early so that long requests do not run into the expiry.
+const installationTokenRefreshMargin = 5 * time.Minute
+
+// appRetryInterval is how long an owner without an installation, or whose
+// token could not be created, uses the personal access token before the app
+// is tried again.
+const appRetryInterval = 10 * time.Minute
+
+// installationTokenTimeout bounds the creation of an installation token. It
+// is not tied to the request that started it, whose context may be canceled
+// while other requests wait for the token.
+const installationTokenTimeout = time.Minute
+
+// appAuth authenticates as a GitHub App. Installation access tokens are
+// created per organization or user on first use and cached until shortly
+// before they expire.
+type appAuth struct {
+	appID   int64
+	key     *rsa.PrivateKey
+	apiURL  string
+	client  *http.Client
+	maxWait time.Duration // of the rate limiters of the installations
+
+	mu      sync.Mutex
+	tokens  map[string]*installationToken // lowercase owner -> token
+	pending map[string]*tokenCall         // lowercase owner -> token being created
+}
+
+// tokenCall is an installation token being created. Concurrent requests for
+// the same owner wait for it instead of creating tokens of their own.
+type tokenCall struct {
+	done  chan struct{}
+	token *installationToken
+	err   error
+}
+
+// installationToken is a cached installation token. Each installation has a
+// quota of its own, its rate limiter is kept when the token is refreshed.
+type installationToken struct {
+	token     string // empty if the owner falls back to the personal access token
+	expiresAt time.Time
+	rate      *rateLimiter
+}
+
+func newAppAuth(cfg config.GitHubAppConfig, apiURL string, client *http.Client, maxWait time.Duration) (*appAuth, error) {
+	data, err := os.ReadFile(cfg.PrivateKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read GitHub App private key: %w", err)
+	}
+	key, err := parsePrivateKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid GitHub App private key %s: %w", cfg.PrivateKeyPath, err)
+	}
+
+	return &appAuth{
+		appID:   cfg.AppID,
+		key:     key,
+		apiURL:  apiURL,
+		client:  client,
+		maxWait: maxWait,
+		tokens:  make(map[string]*installationToken),
+		pending: make(map[string]*tokenCall),
+	}, nil
+}
+
+// parsePrivateKey parses a PEM encoded RSA key. GitHub issues PKCS#1 keys,
+// PKCS#8 is accepted for keys that were converted.
+func parsePrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("not an RSA key")
+	}
+	return key, nil
+}
+
+// jwt returns a token authenticating as the app itself, valid for a few
+// minutes. The issue time is backdated to allow for clock drift.
+func (a *appAuth) jwt() (string, error) {
+	now := time.Now()
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	claims, _ := json.Marshal(map[string]int64{
+		"iat": now.Add(-time.Minute).Unix(),
+		"exp": now.Add(9 * time.Minute).Unix(),
+		"iss": a.appID,
+	})
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, a.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("failed to sign JWT: %w", err)
+	}
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// token returns the installation token of an owner, creating or refreshing it
+// if needed. The token is empty if the app is not installed for the owner.
+// The lock is not held while the token is created, requests for other owners
+// proceed and requests for the same owner wait for the token being created.
+// A request whose context is canceled stops waiting, the token is still
+// created and cached for the others.
+func (a *appAuth) token(ctx context.Context, owner string) (*installationToken, error) {
+	owner = strings.ToLower(owner)
+
+	a.mu.Lock()
+	if t, ok := a.tokens[owner]; ok && time.Until(t.expiresAt) > installationTokenRefreshMargin {
+		a.mu.Unlock()
+		return t, nil
+	}
+	call, ok := a.pending[owner]
+	if !ok {
+		call = &tokenCall{done: make(chan struct{})}
+		a.pending[owner] = call
+		go a.refresh(context.WithoutCancel(ctx), owner, call)
+	}
+	a.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.token, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// refresh creates the installation token of an owner and caches it. A failed
+// creation makes the owner use the personal access token until the app is
+// tried again, unless it only timed out.
+func (a *appAuth) refresh(ctx context.Context, owner string, call *tokenCall) {
+	ctx, cancel := context.WithTimeout(ctx, installationTokenTimeout)
+	defer cancel()
+	t, err := a.createToken(ctx, owner)
+
+	a.mu.Lock()
+	rate := &rateLimiter{maxWait: a.maxWait}
+	if cached, ok := a.tokens[owner]; ok {
+		rate = cached.rate
+	}
+	switch {
+	case err == nil:
+		t.rate = rate
+		a.tokens[owner] = t
+		call.token = t
+	case errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded):
+		// Not a verdict on the installation, the next request tries again
+	default:
+		a.tokens[owner] = &installationToken{expiresAt: time.Now().Add(appRetryInterval + installationTokenRefreshMargin), rate: rate}
+	}
+	delete(a.pending, owner)
+	a.mu.Unlock()
+
+	call.err = err
+	close(call.done)
+}
+
+// invalidate drops the cached installation token of an owner after GitHub
+// rejected it, e.g. because the installation was suspended or the token
+// revoked. The next request creates a new token.
+func (a *appAuth) invalidate(owner, token string) {
+	owner = strings.ToLower(owner)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if t, ok := a.tokens[owner]; ok && t.token == token {
+		// Keep the rate limiter, the installation's quota is unchanged
+		a.tokens[owner] = &installationToken{rate: t.rate}
+		log.Printf("GitHub App installation token for %s was rejected, creating a new one\n", owner)
+	}
+}
+
+// createToken looks up the installation of the app for an organization or
+// user and creates an access token for it.
+func (a *appAuth) createToken(ctx context.Context, owner string) (*installationToken, error) {
+	jwt, err := a.jwt()
+	if err != nil {
+		return nil, err
+	}
+
+	var installation struct {
+		ID int64 `json:"id"`
+	}
+	err = a.request(ctx, "GET", fmt.Sprintf("%s/orgs/%s/installation", a.apiURL, owner), jwt, &installation)
+	if errors.Is(err, ErrNotFound) {
+		err = a.request(ctx, "GET", fmt.Sprintf("%s/users/%s/installation", a.apiURL, owner), jwt, &installation)
+	}
+	if errors.Is(err, ErrNotFound) {
+		log.Printf("GitHub App is not installed for %s, using the personal access token\n", owner)
+		return &installationToken{expiresAt: time.Now().Add(appRetryInterval + installationTokenRefreshMargin)}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get installation of %s: %w", owner, err)
+	}
+
+	var t struct {
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+	apiURL := fmt.Sprintf("%s/app/installations/%d/access_tokens", a.apiURL, installation.ID)
+	if err := a.request(ctx, "POST", apiURL, jwt, &t); err != nil {
+		return nil, fmt.Errorf("failed to create installation token for %s: %w", owner, err)
+	}
+
+	log.Printf("Created GitHub App installation token for %s, valid until %s\n", owner, t.ExpiresAt.Format(time.RFC3339))
+	return &installationToken{token: t.Token, expiresAt: t.ExpiresAt}, nil
+}
+
+// request performs an app-authenticated request and decodes the response
+// into v.
+func (a *appAuth) request(ctx context.Context, method, apiURL, jwt string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, method, apiURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+jwt)
+	req.Header.Set("Accept", jsonMediaType)
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("%w: GitHub API error: %d - %s", ErrNotFound, resp.StatusCode, string(body))
+		}
+		return fmt.Errorf("GitHub API error: %d - %s", resp.StatusCode, string(body))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// urlOwner returns the owner addressed by an API URL, e.g. the owner of
+// /repos/{owner}/{repo}/commits, or "" for other endpoints.
+func (g *GitHubService) urlOwner(apiURL string) string {
+	rest, ok := strings.CutPrefix(apiURL, g.apiURL+"/")
+	if !ok {
+		return ""
+	}
+	parts := strings.SplitN(rest, "/", 3)
+	if len(parts) < 2 {
+		return ""
+	}
+	switch parts[0] {
+	case "repos", "orgs", "users":
+		owner, _, _ := strings.Cut(parts[1], "?")
+		return owner
+	}
+	return ""
+}
+
+type ownerKey struct{}
+
+// withOwner remembers the owner of a paginated request. GitHub links the
+// following pages by repository or organization ID, e.g.
+// /repositories/1300192/issues?page=2, so their URLs do not name the owner.
+func withOwner(ctx context.Context, owner string) context.Context {
+	if owner == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, ownerKey{}, owner)
+}
@@ -0,0 +1,139 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeApp serves the installation endpoints of a GitHub App installed for
+// every owner. Token creation blocks while release is not closed.
+type fakeApp struct {
+	created int32
+	release chan struct{}
+}
+
+func (f *fakeApp) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/installation"):
+		owner := strings.Split(r.URL.Path, "/")[2]
+		fmt.Fprintf(w, `{"id": %d}`, len(owner))
+	case strings.HasSuffix(r.URL.Path, "/access_tokens"):
+		if f.release != nil {
+			<-f.release
+		}
+		n := atomic.AddInt32(&f.created, 1)
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]any{
+			"token":      fmt.Sprintf("ghs_%d", n),
+			"expires_at": time.Now().Add(time.Hour),
+		})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func newTestApp(t *testing.T, api *fakeApp) *GitHubService {
+	t.Helper()
+	g, _, _ := newTestService(t, api)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.app = &appAuth{
+		appID:   1,
+		key:     key,
+		apiURL:  g.apiURL,
+		client:  g.client,
+		maxWait: time.Minute,
+		tokens:  make(map[string]*installationToken),
+		pending: make(map[string]*tokenCall),
+	}
+	return g
+}
+
+func TestAppCredentialRate(t *testing.T) {
+	g := newTestApp(t, &fakeApp{})
+	ctx := context.Background()
+	credential := func(owner string) credential {
+		return g.credential(ctx, g.apiURL+"/repos/"+owner+"/r")
+	}
+
+	a, b := credential("a"), credential("bb")
+	if a.header == "" || b.header == "" {
+		t.Fatalf("no installation tokens: %+v, %+v", a, b)
+	}
+	if a.rate == g.rate || b.rate == g.rate || a.rate == b.rate {
+		t.Error("installations share a rate limiter")
+	}
+
+	// The quota of the installation outlives its token
+	g.app.invalidate("a", a.value)
+	renewed := credential("a")
+	if renewed.value == a.value {
+		t.Errorf("token %s was not renewed", a.value)
+	}
+	if renewed.rate != a.rate {
+		t.Error("renewed token has a new rate limiter")
+	}
+}
+
+func TestAppTokenCanceled(t *testing.T) {
+	api := &fakeApp{release: make(chan struct{})}
+	g := newTestApp(t, api)
+
+	// The request that starts the creation gives up, the creation goes on
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error)
+	go func() {
+		_, err := g.app.token(ctx, "o")
+		errs <- err
+	}()
+	cancel()
+	if err := <-errs; !errors.Is(err, context.Canceled) {
+		t.Fatalf("canceled request returned %v", err)
+	}
+	close(api.release)
+
+	tok, err := g.app.token(context.Background(), "o")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tok.token != "ghs_1" {
+		t.Errorf("token = %q, want ghs_1", tok.token)
+	}
+	if n := atomic.LoadInt32(&api.created); n != 1 {
+		t.Errorf("created %d tokens, want 1", n)
+	}
+}
+
+func TestAppTokenCanceledNotCached(t *testing.T) {
+	g := newTestApp(t, &fakeApp{})
+	g.app.tokens["o"] = &installationToken{rate: &rateLimiter{}}
+
+	call := &tokenCall{done: make(chan struct{})}
+	g.app.pending["o"] = call
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	g.app.refresh(ctx, "o", call)
+	if !errors.Is(call.err, context.Canceled) {
+		t.Fatalf("refresh returned %v", call.err)
+	}
+
+	// The next request creates the token instead of falling back
+	tok, err := g.app.token(context.Background(), "o")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tok.token == "" {
+		t.Error("canceled creation was cached as a fallback to the personal access token")
+	}
+}
@@ -0,0 +1,469 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"twt/config"
+	"twt/models"
+)
+
+// newTestService returns a service talking to a fake GitHub API and an empty
+// database. The path of the database is returned for tests that need to
+// tamper with it directly.
+func newTestService(t *testing.T, handler http.Handler) (*GitHubService, *models.DB, string) {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	dbPath := filepath.Join(t.TempDir(), "test.db")
+	db, err := models.NewDB(dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	g, err := NewGitHubService(config.GithubConfig{APIURL: srv.URL, MaxRetries: 1}, nil, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g, db, dbPath
+}
+
+// failCommitSaves makes storing the commit with the given SHA fail until the
+// returned function is called.
+func failCommitSaves(t *testing.T, dbPath, sha string) (restore func()) {
+	t.Helper()
+	conn, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	_, err = conn.Exec(`CREATE TRIGGER fail_commit BEFORE INSERT ON commits
+		WHEN NEW.sha = '` + sha + `' BEGIN SELECT RAISE(ABORT, 'disk full'); END`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return func() {
+		if _, err := conn.Exec(`DROP TRIGGER fail_commit`); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// testCommit is a commit served by the fake API.
+type testCommit struct {
+	sha  string
+	date time.Time
+}
+
+func writeCommits(w http.ResponseWriter, commits []testCommit) {
+	list := make([]GitHubCommit, len(commits))
+	for i, c := range commits {
+		list[i].SHA = c.sha
+		list[i].Commit.Message = "commit " + c.sha
+		list[i].Commit.Author.Name = "Author"
+		list[i].Commit.Author.Email = "author@example.com"
+		list[i].Commit.Author.Date = c.date
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(list)
+}
+
+// commitSHAs returns the SHAs of commits in order.
+func commitSHAs(commits []*models.Commit) []string {
+	shas := make([]string, len(commits))
+	for i, c := range commits {
+		shas[i] = c.SHA
+	}
+	return shas
+}
+
+func TestNextPageURL(t *testing.T) {
+	tests := []struct {
+		name string
+		link string
+		want string
+	}{
+		{"empty", "", ""},
+		{
+			"next and last",
+			`<https://api.github.com/repositories/1/commits?page=2>; rel="next", <https://api.github.com/repositories/1/commits?page=5>; rel="last"`,
+			"https://api.github.com/repositories/1/commits?page=2",
+		},
+		{
+			"next not first",
+			`<https://api.github.com/repos/o/r/issues?page=1>; rel="prev", <https://api.github.com/repos/o/r/issues?page=3>; rel="next"`,
+			"https://api.github.com/repos/o/r/issues?page=3",
+		},
+		{
+			"last page",
+			`<https://api.github.com/repos/o/r/issues?page=1>; rel="first", <https://api.github.com/repos/o/r/issues?page=2>; rel="prev"`,
+			"",
+		},
+		{"malformed", `<https://api.github.com/repos/o/r/issues?page=2>`, ""},
+	}
+
+	for _, tt := range tests {
+		if got := nextPageURL(tt.link); got != tt.want {
+			t.Errorf("%s: nextPageURL() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+// fakeHistory serves the commits of the branches of a repository, newest
+// first, honoring the sha, since and per_page parameters of /commits.
+type fakeHistory struct {
+	mu       sync.Mutex
+	branches map[string][]testCommit // the default branch is "main"
+	queries  []url.Values
+}
+
+func (h *fakeHistory) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	query := r.URL.Query()
+	h.queries = append(h.queries, query)
+	branch := query.Get("sha")
+	if branch == "" {
+		branch = "main"
+	}
+	since, _ := time.Parse(time.RFC3339, query.Get("since"))
+	perPage, _ := strconv.Atoi(query.Get("per_page"))
+
+	var commits []testCommit
+	for _, c := range h.branches[branch] {
+		if c.date.Before(since) || (perPage > 0 && len(commits) == perPage) {
+			continue
+		}
+		commits = append(commits, c)
+	}
+	writeCommits(w, commits)
+}
+
+// push adds a commit on top of a branch.
+func (h *fakeHistory) push(branch string, c testCommit) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.branches[branch] = append([]testCommit{c}, h.branches[branch]...)
+}
+
+// lastQuery returns the query of the most recent request.
+func (h *fakeHistory) lastQuery() url.Values {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.queries[len(h.queries)-1]
+}
+
+// lastQueryURL returns the commits URL of the most recent request.
+func (h *fakeHistory) lastQueryURL(apiURL string) string {
+	return apiURL + "/repos/o/r/commits?" + h.lastQuery().Encode()
+}
+
+func TestSyncCommitsIncremental(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 12, 0, 0, 0, time.UTC) }
+	api := &fakeHistory{branches: map[string][]testCommit{
+		"main": {{"c3", day(3)}, {"c2", day(2)}, {"c1", day(1)}},
+	}}
+	g, db, _ := newTestService(t, api)
+	ctx := context.Background()
+
+	// Without stored commits only the newest limit commits are fetched
+	stats, err := g.SyncCommits(ctx, "o/r", 2, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats != (CommitSyncStats{New: 2}) {
+		t.Errorf("first sync stats = %+v, want 2 new", stats)
+	}
+	if q := api.lastQuery(); q.Get("since") != "" || q.Get("per_page") != "2" {
+		t.Errorf("first sync query = %v, want per_page=2 without since", q)
+	}
+
+	// Nothing new: the newest stored commit is the lower bound
+	stats, err = g.SyncCommits(ctx, "o/r", 2, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats != (CommitSyncStats{Known: 1}) {
+		t.Errorf("unchanged sync stats = %+v, want 1 known", stats)
+	}
+	if since := api.lastQuery().Get("since"); since != day(3).Format(time.RFC3339) {
+		t.Errorf("since = %q, want the date of c3", since)
+	}
+
+	api.push("main", testCommit{"c4", day(4)})
+	api.push("main", testCommit{"c5", day(5)})
+	stats, err = g.SyncCommits(ctx, "o/r", 2, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats != (CommitSyncStats{New: 2, Known: 1}) {
+		t.Errorf("sync stats = %+v, want 2 new, 1 known", stats)
+	}
+
+	commits, err := db.GetCommits("o/r", 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"c5", "c4", "c3", "c2"}
+	if got := commitSHAs(commits); !reflect.DeepEqual(got, want) {
+		t.Errorf("stored commits %v, want %v", got, want)
+	}
+}
+
+func TestSyncCommitsStopsAtFailedSave(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 12, 0, 0, 0, time.UTC) }
+	api := &fakeHistory{branches: map[string][]testCommit{"main": {{"c1", day(1)}}}}
+	g, db, dbPath := newTestService(t, api)
+	ctx := context.Background()
+
+	if _, err := g.SyncCommits(ctx, "o/r", 10, db); err != nil {
+		t.Fatal(err)
+	}
+	api.push("main", testCommit{"c2", day(2)})
+	api.push("main", testCommit{"c3", day(3)})
+
+	// c3 must not be stored without c2, it would move since past c2
+	restore := failCommitSaves(t, dbPath, "c2")
+	if _, err := g.SyncCommits(ctx, "o/r", 10, db); err == nil {
+		t.Fatal("sync succeeded despite a failing commit")
+	}
+	if ok, _ := db.HasCommit("c3", "o/r"); ok {
+		t.Error("c3 was stored before the missing c2")
+	}
+
+	restore()
+	stats, err := g.SyncCommits(ctx, "o/r", 10, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.New != 2 {
+		t.Errorf("retried sync stored %d commits, want 2", stats.New)
+	}
+}
+
+func TestCacheKey(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"https://api.github.com/repos/o/r", "https://api.github.com/repos/o/r"},
+		{"https://api.github.com/repos/o/r/releases?per_page=100", "https://api.github.com/repos/o/r/releases?per_page=100"},
+		{
+			"https://api.github.com/repos/o/r/commits?per_page=100&since=2024-01-01T00%3A00%3A00Z",
+			"https://api.github.com/repos/o/r/commits?per_page=100",
+		},
+		{
+			"https://api.github.com/repos/o/r/commits?per_page=100&sha=dev&since=2024-01-02T00%3A00%3A00Z",
+			"https://api.github.com/repos/o/r/commits?per_page=100&sha=dev",
+		},
+	}
+
+	for _, tt := range tests {
+		if got := cacheKey(tt.url); got != tt.want {
+			t.Errorf("cacheKey(%q) = %q, want %q", tt.url, got, tt.want)
+		}
+	}
+}
+
+// etagHandler answers with the ETag returned by etag and with 304 if the
+// request already carries it.
+func etagHandler(next http.Handler, etag func() string, requests *int32) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		tag := etag()
+		if r.Header.Get("If-None-Match") == tag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", tag)
+		next.ServeHTTP(w, r)
+	})
+}
+
+func TestGetCached(t *testing.T) {
+	version := "v1"
+	var requests int32
+	api := etagHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	}), func() string { return `"` + version + `"` }, &requests)
+	g, db, _ := newTestService(t, api)
+	ctx := context.Background()
+	apiURL := g.apiURL + "/repos/o/r"
+
+	fetch := func(conditional bool) error {
+		resp, err := g.fetch(ctx, apiURL, jsonMediaType, conditional)
+		if err == nil {
+			resp.Body.Close()
+		}
+		return err
+	}
+
+	if err := fetch(true); err != nil {
+		t.Fatal(err)
+	}
+	if entry, err := db.GetHTTPCache(apiURL); err != nil || entry == nil || entry.ETag != `"v1"` {
+		t.Fatalf("cache entry = %+v, %v", entry, err)
+	}
+	if err := fetch(true); !errors.Is(err, ErrNotModified) {
+		t.Errorf("unchanged resource: err = %v, want ErrNotModified", err)
+	}
+	// Plain requests are never conditional
+	if err := fetch(false); err != nil {
+		t.Errorf("unconditional request: %v", err)
+	}
+
+	version = "v2"
+	if err := fetch(true); err != nil {
+		t.Errorf("changed resource: %v", err)
+	}
+	if entry, _ := db.GetHTTPCache(apiURL); entry == nil || entry.ETag != `"v2"` {
+		t.Errorf("cache entry = %+v, want the new ETag", entry)
+	}
+
+	g.dropValidators(apiURL)
+	if entry, _ := db.GetHTTPCache(apiURL); entry != nil {
+		t.Errorf("cache entry = %+v after dropValidators", entry)
+	}
+	if got := atomic.LoadInt32(&requests); got != 4 {
+		t.Errorf("%d requests, want 4", got)
+	}
+}
+
+func TestSyncCommitsConditional(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 12, 0, 0, 0, time.UTC) }
+	history := &fakeHistory{branches: map[string][]testCommit{"main": {{"c1", day(1)}}}}
+	var requests int32
+	api := etagHandler(history, func() string {
+		history.mu.Lock()
+		defer history.mu.Unlock()
+		return fmt.Sprintf(`"%d"`, len(history.branches["main"]))
+	}, &requests)
+	g, db, dbPath := newTestService(t, api)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if _, err := g.SyncCommits(ctx, "o/r", 10, db); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The since of the incremental syncs is not part of the cache key
+	key := cacheKey(history.lastQueryURL(g.apiURL))
+	if entry, err := db.GetHTTPCache(key); err != nil || entry == nil {
+		t.Fatalf("no cache entry for %s: %v", key, err)
+	}
+
+	// Validators of a page whose commits were not stored are dropped, or
+	// the retry would be answered with 304 and the commit never stored
+	history.push("main", testCommit{"c2", day(2)})
+	restore := failCommitSaves(t, dbPath, "c2")
+	if _, err := g.SyncCommits(ctx, "o/r", 10, db); err == nil {
+		t.Fatal("sync succeeded despite a failing commit")
+	}
+	restore()
+	stats, err := g.SyncCommits(ctx, "o/r", 10, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.New != 1 {
+		t.Errorf("retried sync stored %d commits, want 1", stats.New)
+	}
+}
+
+func TestParseRepositoryURL(t *testing.T) {
+	g := &GitHubService{providers: map[string]Provider{
+		"ghe.example.com": &GitHubService{host: "ghe.example.com"},
+		"gitlab.com":      &GitLabProvider{},
+		"gitea.com":       &GiteaProvider{},
+	}}
+
+	tests := []struct {
+		url     string
+		want    string
+		wantErr bool
+	}{
+		{url: "https://github.com/gin-gonic/gin", want: "gin-gonic/gin"},
+		{url: "https://github.com/gin-gonic/gin.git", want: "gin-gonic/gin"},
+		{url: "http://github.com/gin-gonic/gin/tree/master", want: "gin-gonic/gin"},
+		{url: "github.com/gin-gonic/gin", want: "gin-gonic/gin"},
+		{url: "gin-gonic/gin", want: "gin-gonic/gin"},
+		{url: "https://ghe.example.com/org/repo", want: "ghe.example.com/org/repo"},
+		{url: "https://ghe.example.com/org/repo/tree/main", want: "ghe.example.com/org/repo"},
+		{url: "https://GHE.example.com/org/repo.git", want: "ghe.example.com/org/repo"},
+		{url: "https://gitlab.com/gitlab-org/gitlab", want: "gitlab.com/gitlab-org/gitlab"},
+		{url: "https://gitlab.com/group/sub/project", want: "gitlab.com/group/sub/project"},
+		{url: "https://gitlab.com/group/sub/project/-/tree/main", want: "gitlab.com/group/sub/project"},
+		{url: "https://gitlab.com/group/sub/project.git", want: "gitlab.com/group/sub/project"},
+		{url: "https://gitea.com/gitea/tea", want: "gitea.com/gitea/tea"},
+		{url: "https://gitea.com/gitea/tea/src/branch/main", want: "gitea.com/gitea/tea"},
+		{url: "https://github.com/gin-gonic", wantErr: true},
+		{url: "https://gitlab.com/group", wantErr: true},
+		{url: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := g.parseRepositoryURL(tt.url)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseRepositoryURL(%q) error = %v, wantErr %v", tt.url, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseRepositoryURL(%q) = %q, want %q", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestSyncRepositoryName(t *testing.T) {
+	var requests int32
+	api := etagHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Names are case-insensitive, the API returns the canonical one
+		fmt.Fprint(w, `{"name": "Gin", "full_name": "Gin-Gonic/Gin"}`)
+	}), func() string { return `"v1"` }, &requests)
+	g, db, _ := newTestService(t, api)
+	ctx := context.Background()
+
+	for i, tt := range []struct {
+		url                       string
+		added, updated, unchanged int
+	}{
+		{url: "gin-gonic/gin", added: 1},
+		{url: "GIN-GONIC/gin", updated: 1},
+		{url: "gin-gonic/gin", unchanged: 1},
+	} {
+		result := g.syncRepository(ctx, tt.url, db)
+		if result.Status != SyncStatusSuccess {
+			t.Fatalf("sync %d of %s failed: %s", i, tt.url, result.Error)
+		}
+		if result.Repository != "Gin-Gonic/Gin" {
+			t.Errorf("sync %d of %s reported %q, want Gin-Gonic/Gin", i, tt.url, result.Repository)
+		}
+		if result.Added != tt.added || result.Updated != tt.updated || result.Unchanged != tt.unchanged {
+			t.Errorf("sync %d of %s: added %d, updated %d, unchanged %d, want %d, %d, %d", i, tt.url,
+				result.Added, result.Updated, result.Unchanged, tt.added, tt.updated, tt.unchanged)
+		}
+	}
+
+	repos, err := db.GetRepositories()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(repos) != 1 {
+		t.Errorf("stored %d repositories, want 1", len(repos))
+	}
+}
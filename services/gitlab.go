@@ -0,0 +1,179 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"twt/models"
+)
+
+// GitLabProvider syncs repositories (projects) from gitlab.com or a
+// self-managed GitLab instance.
+type GitLabProvider struct {
+	host string
+	api  *apiClient
+}
+
+type GitLabProject struct {
+	ID                int      `json:"id"`
+	Path              string   `json:"path"`
+	PathWithNamespace string   `json:"path_with_namespace"`
+	Description       *string  `json:"description"`
+	WebURL            string   `json:"web_url"`
+	Topics            []string `json:"topics"`
+	DefaultBranch     string   `json:"default_branch"`
+	Archived          bool     `json:"archived"`
+	Visibility        string   `json:"visibility"`
+	ForkedFromProject *struct {
+		ID int `json:"id"`
+	} `json:"forked_from_project"`
+	StarCount       int       `json:"star_count"`
+	ForksCount      int       `json:"forks_count"`
+	OpenIssuesCount int       `json:"open_issues_count"`
+	CreatedAt       time.Time `json:"created_at"`
+	LastActivityAt  time.Time `json:"last_activity_at"`
+}
+
+type GitLabCommit struct {
+	ID           string    `json:"id"`
+	Message      string    `json:"message"`
+	AuthorName   string    `json:"author_name"`
+	AuthorEmail  string    `json:"author_email"`
+	AuthoredDate time.Time `json:"authored_date"`
+}
+
+type GitLabRelease struct {
+	TagName         string     `json:"tag_name"`
+	Name            string     `json:"name"`
+	Description     string     `json:"description"`
+	UpcomingRelease bool       `json:"upcoming_release"`
+	CreatedAt       time.Time  `json:"created_at"`
+	ReleasedAt      *time.Time `json:"released_at"`
+	Author          struct {
+		Username string `json:"username"`
+	} `json:"author"`
+	Links struct {
+		Self string `json:"self"`
+	} `json:"_links"`
+}
+
+// NewGitLabProvider creates a provider for the GitLab instance at host with
+// the given API base URL, e.g. https://gitlab.com/api/v4, authenticating with
+// a personal or project access token if set.
+func NewGitLabProvider(host, apiURL, token string, client *http.Client) *GitLabProvider {
+	return &GitLabProvider{
+		host: host,
+		api: &apiClient{
+			name:    "GitLab",
+			baseURL: apiURL,
+			header:  "PRIVATE-TOKEN",
+			token:   token,
+			client:  client,
+		},
+	}
+}
+
+// projectAPIURL returns the API URL of a project. GitLab addresses projects
+// by their URL-encoded path.
+func (p *GitLabProvider) projectAPIURL(fullName string) string {
+	_, projectPath := splitRepositoryHost(fullName)
+	return fmt.Sprintf("%s/projects/%s", p.api.baseURL, url.PathEscape(projectPath))
+}
+
+func (p *GitLabProvider) GetRepositoryInfo(ctx context.Context, fullName string) (*models.Repository, error) {
+	var project GitLabProject
+	if err := p.api.getJSON(ctx, p.projectAPIURL(fullName), &project); err != nil {
+		return nil, err
+	}
+
+	repo := &models.Repository{
+		Name:          project.Path,
+		FullName:      p.host + "/" + project.PathWithNamespace,
+		URL:           project.WebURL,
+		Topics:        project.Topics,
+		DefaultBranch: project.DefaultBranch,
+		Archived:      project.Archived,
+		Fork:          project.ForkedFromProject != nil,
+		Private:       project.Visibility != "public",
+		Stars:         project.StarCount,
+		Forks:         project.ForksCount,
+		OpenIssues:    project.OpenIssuesCount,
+		CreatedAt:     project.CreatedAt,
+		UpdatedAt:     project.LastActivityAt,
+	}
+	if project.Description != nil {
+		repo.Description = *project.Description
+	}
+	return repo, nil
+}
+
+func (p *GitLabProvider) GetCommits(ctx context.Context, fullName string, limit int) ([]*models.Commit, error) {
+	if limit <= 0 {
+		limit = 50 // default limit
+	}
+	apiURL := fmt.Sprintf("%s/repository/commits?per_page=%d", p.projectAPIURL(fullName), limit)
+
+	var gitlabCommits []GitLabCommit
+	if err := p.api.getJSON(ctx, apiURL, &gitlabCommits); err != nil {
+		return nil, err
+	}
+
+	var commits []*models.Commit
+	for _, gc := range gitlabCommits {
+		commits = append(commits, &models.Commit{
+			SHA:                gc.ID,
+			Message:            gc.Message,
+			AuthorName:         gc.AuthorName,
+			AuthorEmail:        gc.AuthorEmail,
+			CommitDate:         gc.AuthoredDate,
+			RepositoryFullName: fullName,
+		})
+	}
+	return commits, nil
+}
+
+func (p *GitLabProvider) GetReleases(ctx context.Context, fullName string) ([]*models.Release, error) {
+	apiURL := fmt.Sprintf("%s/releases?per_page=%d", p.projectAPIURL(fullName), maxPerPage)
+
+	var releases []*models.Release
+	err := p.api.getAllPages(ctx, apiURL, func(body io.Reader) error {
+		var gitlabReleases []GitLabRelease
+		if err := json.NewDecoder(body).Decode(&gitlabReleases); err != nil {
+			return err
+		}
+		for _, gr := range gitlabReleases {
+			releases = append(releases, &models.Release{
+				ReleaseID:          tagReleaseID(gr.TagName),
+				RepositoryFullName: fullName,
+				TagName:            gr.TagName,
+				Name:               gr.Name,
+				Body:               gr.Description,
+				Prerelease:         gr.UpcomingRelease,
+				Author:             gr.Author.Username,
+				URL:                gr.Links.Self,
+				CreatedAt:          gr.CreatedAt,
+				PublishedAt:        gr.ReleasedAt,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get releases: %w", err)
+	}
+	return releases, nil
+}
+
+// tagReleaseID derives the ID of a GitLab release, which has none, from its
+// tag name. A release is identified by its tag, so the ID stays the same when
+// releases are added or removed.
+func tagReleaseID(tagName string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(tagName))
+	return int64(h.Sum64() >> 1)
+}
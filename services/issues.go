@@ -0,0 +1,115 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"time"
+
+	"twt/models"
+)
+
+// GitHubIssue is an entry of the issues API, which also lists pull requests.
+// Those carry a pull_request object.
+type GitHubIssue struct {
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+	State  string `json:"state"`
+	User   struct {
+		Login string `json:"login"`
+	} `json:"user"`
+	Labels []struct {
+		Name string `json:"name"`
+	} `json:"labels"`
+	Assignees []struct {
+		Login string `json:"login"`
+	} `json:"assignees"`
+	Comments    int        `json:"comments"`
+	HTMLURL     string     `json:"html_url"`
+	Draft       bool       `json:"draft"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	ClosedAt    *time.Time `json:"closed_at"`
+	PullRequest *struct {
+		MergedAt *time.Time `json:"merged_at"`
+	} `json:"pull_request"`
+}
+
+// syncIssues fetches the issues and pull requests updated since the newest
+// stored one, or all of them on the first sync. An unchanged first page means
+// nothing was updated since the last sync.
+func (g *GitHubService) syncIssues(ctx context.Context, repositoryFullName string, db *models.DB) error {
+	since, err := db.GetLatestIssueUpdate(repositoryFullName)
+	if err != nil {
+		return fmt.Errorf("failed to get latest issue update: %w", err)
+	}
+
+	query := url.Values{}
+	query.Set("state", "all")
+	query.Set("sort", "updated")
+	query.Set("direction", "asc")
+	query.Set("per_page", fmt.Sprint(maxPerPage))
+	if !since.IsZero() {
+		query.Set("since", since.UTC().Format(time.RFC3339))
+	}
+	apiURL := fmt.Sprintf("%s/issues?%s", g.repositoryAPIURL(repositoryFullName), query.Encode())
+
+	var issues, pulls int
+	err = g.getAllPagesCached(ctx, apiURL, func(body io.Reader) error {
+		var githubIssues []GitHubIssue
+		if err := json.NewDecoder(body).Decode(&githubIssues); err != nil {
+			return err
+		}
+
+		// Pages are saved as they arrive, oldest update first, so an
+		// interrupted sync resumes from the last saved page
+		for _, gi := range githubIssues {
+			issue := models.Issue{
+				RepositoryFullName: repositoryFullName,
+				Number:             gi.Number,
+				Title:              gi.Title,
+				State:              gi.State,
+				Author:             gi.User.Login,
+				Comments:           gi.Comments,
+				URL:                gi.HTMLURL,
+				CreatedAt:          gi.CreatedAt,
+				UpdatedAt:          gi.UpdatedAt,
+				ClosedAt:           gi.ClosedAt,
+			}
+			for _, label := range gi.Labels {
+				issue.Labels = append(issue.Labels, label.Name)
+			}
+			for _, assignee := range gi.Assignees {
+				issue.Assignees = append(issue.Assignees, assignee.Login)
+			}
+
+			if gi.PullRequest != nil {
+				pr := &models.PullRequest{Issue: issue, Draft: gi.Draft, MergedAt: gi.PullRequest.MergedAt}
+				if err := db.SavePullRequest(pr); err != nil {
+					return fmt.Errorf("failed to save pull request #%d: %w", gi.Number, err)
+				}
+				pulls++
+				continue
+			}
+			if err := db.SaveIssue(&issue); err != nil {
+				return fmt.Errorf("failed to save issue #%d: %w", gi.Number, err)
+			}
+			issues++
+		}
+		return nil
+	})
+	if errors.Is(err, ErrNotModified) {
+		log.Printf("Issues unchanged: %s\n", repositoryFullName)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to sync issues: %w", err)
+	}
+
+	log.Printf("Successfully synced %d issues and %d pull requests for repository: %s\n", issues, pulls, repositoryFullName)
+	return nil
+}
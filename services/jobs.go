@@ -0,0 +1,310 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"twt/models"
+)
+
+// Sync job triggers
+const (
+	TriggerHTTP      = "http"
+	TriggerGRPC      = "grpc"
+	TriggerScheduler = "scheduler"
+)
+
+// Sync job kinds
+const (
+	JobKindRepositories = "repositories"
+	JobKindCommits      = "commits"
+	JobKindBackfill     = "backfill"
+)
+
+const JobStatusRunning = "running"
+
+// ErrJobNotRunning is returned when canceling a job that already finished.
+var ErrJobNotRunning = errors.New("sync job is not running")
+
+// SyncTask describes the work done by a sync job.
+type SyncTask struct {
+	Kind         string
+	Repositories []string
+	run          func(ctx context.Context, m *JobManager, observe SyncObserver) *SyncReport
+	observe      SyncObserver
+}
+
+// WithObserver returns a copy of the task that also reports its events to
+// observe, e.g. to stream progress to a client.
+func (t SyncTask) WithObserver(observe SyncObserver) SyncTask {
+	t.observe = observe
+	return t
+}
+
+// RepositoriesTask syncs repository metadata.
+func RepositoriesTask(repoURLs []string) SyncTask {
+	return SyncTask{
+		Kind:         JobKindRepositories,
+		Repositories: repoURLs,
+		run: func(ctx context.Context, m *JobManager, observe SyncObserver) *SyncReport {
+			report, _ := m.github.SyncRepositories(ctx, repoURLs, m.db, observe)
+			return report
+		},
+	}
+}
+
+// CommitsTask incrementally syncs commits. repos may be repository URLs or
+// full names.
+func CommitsTask(repos []string, limit int) SyncTask {
+	return SyncTask{
+		Kind:         JobKindCommits,
+		Repositories: repos,
+		run: func(ctx context.Context, m *JobManager, observe SyncObserver) *SyncReport {
+			report, _ := m.github.SyncCommitsAll(ctx, repos, limit, m.db, observe)
+			return report
+		},
+	}
+}
+
+// BackfillTask backfills the commit history of one repository.
+func BackfillTask(fullName string, since time.Time) SyncTask {
+	return SyncTask{
+		Kind:         JobKindBackfill,
+		Repositories: []string{fullName},
+		run: func(ctx context.Context, m *JobManager, observe SyncObserver) *SyncReport {
+			report := &SyncReport{StartedAt: time.Now()}
+			result := &RepoSyncResult{Repository: fullName, Status: SyncStatusSuccess}
+			observe.emit(SyncEvent{Type: EventRepositoryStarted, Repository: fullName})
+
+			count, err := m.github.BackfillCommits(ctx, fullName, since, m.db, observe)
+			result.Added = count
+			event := SyncEvent{Type: EventRepositoryFinished, Repository: fullName, Result: result}
+			if err != nil {
+				result.Status = SyncStatusFailed
+				result.Error = err.Error()
+				event.Type = EventRepositoryFailed
+			}
+			result.Duration = time.Since(report.StartedAt)
+			result.DurationMS = result.Duration.Milliseconds()
+			observe.emit(event)
+
+			report.Results = []*RepoSyncResult{result}
+			report.add(result)
+			report.finish(ctx)
+			return report
+		},
+	}
+}
+
+// JobManager runs sync tasks as jobs recorded in the sync_jobs table. Jobs
+// can run synchronously or in the background, and running jobs can be polled
+// for progress and canceled.
+type JobManager struct {
+	github *GitHubService
+	db     *models.DB
+
+	mu      sync.Mutex
+	running map[int64]*runningJob
+	closed  bool
+	wg      sync.WaitGroup
+}
+
+// runningJob is the in-memory state of a job that has not finished yet.
+type runningJob struct {
+	cancel context.CancelFunc
+
+	mu      sync.Mutex
+	done    int
+	current map[string]bool
+}
+
+func NewJobManager(githubService *GitHubService, db *models.DB) *JobManager {
+	// Jobs still marked running were interrupted by a restart
+	if err := db.InterruptRunningSyncJobs(); err != nil {
+		log.Printf("Failed to mark interrupted sync jobs: %v\n", err)
+	}
+
+	return &JobManager{
+		github:  githubService,
+		db:      db,
+		running: make(map[int64]*runningJob),
+	}
+}
+
+// Run executes a task and waits for it to finish.
+func (m *JobManager) Run(trigger string, task SyncTask) (*models.SyncJob, *SyncReport) {
+	return m.RunContext(context.Background(), trigger, task)
+}
+
+// RunContext is like Run but also cancels the job when ctx is done.
+func (m *JobManager) RunContext(ctx context.Context, trigger string, task SyncTask) (*models.SyncJob, *SyncReport) {
+	job, state, ctx := m.begin(ctx, trigger, task)
+	report := m.execute(ctx, job, state, task)
+	return job, report
+}
+
+// Start executes a task in the background and returns the running job.
+func (m *JobManager) Start(trigger string, task SyncTask) *models.SyncJob {
+	job, state, ctx := m.begin(context.Background(), trigger, task)
+	snapshot := *job
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		m.execute(ctx, job, state, task)
+	}()
+	return &snapshot
+}
+
+// Cancel stops a running job. Repositories already in flight are aborted
+// through their request context.
+func (m *JobManager) Cancel(id int64) error {
+	m.mu.Lock()
+	state, ok := m.running[id]
+	m.mu.Unlock()
+	if ok {
+		state.cancel()
+		return nil
+	}
+
+	if _, err := m.db.GetSyncJob(id); err != nil {
+		return err
+	}
+	return ErrJobNotRunning
+}
+
+// Shutdown cancels all running jobs and waits for background jobs to record
+// their result. Jobs started afterwards are canceled right away.
+func (m *JobManager) Shutdown() {
+	m.mu.Lock()
+	m.closed = true
+	for _, state := range m.running {
+		state.cancel()
+	}
+	m.mu.Unlock()
+	m.wg.Wait()
+}
+
+// GetJob returns a job with the live progress of running jobs.
+func (m *JobManager) GetJob(id int64) (*models.SyncJob, error) {
+	job, err := m.db.GetSyncJob(id)
+	if err != nil {
+		return nil, err
+	}
+	m.withProgress(job)
+	return job, nil
+}
+
+// ListJobs returns recent jobs with the live progress of running jobs.
+func (m *JobManager) ListJobs(limit, offset int) ([]*models.SyncJob, error) {
+	jobs, err := m.db.GetSyncJobs(limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	for _, job := range jobs {
+		m.withProgress(job)
+	}
+	return jobs, nil
+}
+
+func (m *JobManager) withProgress(job *models.SyncJob) {
+	m.mu.Lock()
+	state, ok := m.running[job.ID]
+	m.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	job.Done = state.done
+	job.Current = make([]string, 0, len(state.current))
+	for repo := range state.current {
+		job.Current = append(job.Current, repo)
+	}
+	sort.Strings(job.Current)
+}
+
+func (m *JobManager) begin(parent context.Context, trigger string, task SyncTask) (*models.SyncJob, *runningJob, context.Context) {
+	job := &models.SyncJob{
+		Kind:         task.Kind,
+		Trigger:      trigger,
+		Status:       JobStatusRunning,
+		Repositories: task.Repositories,
+		Total:        len(task.Repositories),
+		StartedAt:    time.Now(),
+	}
+	// Failing to record the job is logged but never prevents the sync itself
+	if err := m.db.CreateSyncJob(job); err != nil {
+		log.Printf("Failed to record %s sync job: %v\n", task.Kind, err)
+	}
+
+	ctx, cancel := context.WithCancel(parent)
+	state := &runningJob{cancel: cancel, current: make(map[string]bool)}
+	m.mu.Lock()
+	if m.closed {
+		cancel()
+	} else if job.ID != 0 {
+		m.running[job.ID] = state
+	}
+	m.mu.Unlock()
+	return job, state, ctx
+}
+
+func (m *JobManager) execute(ctx context.Context, job *models.SyncJob, state *runningJob, task SyncTask) *SyncReport {
+	defer func() {
+		state.cancel()
+		m.mu.Lock()
+		delete(m.running, job.ID)
+		m.mu.Unlock()
+	}()
+
+	report := task.run(ctx, m, func(event SyncEvent) {
+		state.mu.Lock()
+		switch event.Type {
+		case EventRepositoryStarted:
+			state.current[event.Repository] = true
+		case EventRepositoryFinished, EventRepositoryFailed:
+			delete(state.current, event.Repository)
+			state.done++
+		}
+		done := state.done
+		state.mu.Unlock()
+
+		if (event.Type == EventRepositoryFinished || event.Type == EventRepositoryFailed) && job.ID != 0 {
+			if err := m.db.UpdateSyncJobProgress(job.ID, done); err != nil {
+				log.Printf("Failed to update progress of sync job %d: %v\n", job.ID, err)
+			}
+		}
+		task.observe.emit(event)
+	})
+
+	finishedAt := time.Now()
+	job.FinishedAt = &finishedAt
+	job.Status = report.Status
+	job.Succeeded = report.Succeeded
+	job.Failed = report.Failed
+	job.Added = report.Added
+	job.Updated = report.Updated
+	job.Done = report.Succeeded + report.Failed
+	for _, result := range report.Results {
+		if result.Status == SyncStatusFailed {
+			job.Errors = append(job.Errors, fmt.Sprintf("%s: %s", result.Repository, result.Error))
+		}
+		for _, warning := range result.Warnings {
+			job.Errors = append(job.Errors, fmt.Sprintf("%s: %s", result.Repository, warning))
+		}
+	}
+
+	if job.ID != 0 {
+		if err := m.db.UpdateSyncJob(job); err != nil {
+			log.Printf("Failed to update sync job %d: %v\n", job.ID, err)
+		}
+	}
+	return report
+}
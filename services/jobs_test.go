@@ -0,0 +1,143 @@
+package services
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestJobManagerRun(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 12, 0, 0, 0, time.UTC) }
+	api := &fakeHistory{branches: map[string][]testCommit{
+		"main": {{"c2", day(2)}, {"c1", day(1)}},
+	}}
+	g, db, _ := newTestService(t, api)
+	m := NewJobManager(g, db)
+
+	job, report := m.Run(TriggerHTTP, CommitsTask([]string{"o/r"}, 10))
+	if job.ID == 0 {
+		t.Fatal("job was not recorded")
+	}
+	if report.Status != SyncStatusSuccess || report.Added != 2 {
+		t.Errorf("report = %+v, want success with 2 added", report)
+	}
+
+	stored, err := m.GetJob(job.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Kind != JobKindCommits || stored.Trigger != TriggerHTTP || stored.Status != SyncStatusSuccess ||
+		stored.Total != 1 || stored.Done != 1 || stored.Succeeded != 1 || stored.Added != 2 || stored.FinishedAt == nil {
+		t.Errorf("stored job = %+v", stored)
+	}
+
+	if err := m.Cancel(job.ID); err != ErrJobNotRunning {
+		t.Errorf("Cancel of a finished job: err = %v, want ErrJobNotRunning", err)
+	}
+
+	jobs, err := m.ListJobs(10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 1 || jobs[0].ID != job.ID {
+		t.Errorf("ListJobs() = %v, want the job", jobs)
+	}
+}
+
+func TestJobManagerRunFailure(t *testing.T) {
+	g, db, _ := newTestService(t, http.NotFoundHandler())
+	m := NewJobManager(g, db)
+
+	job, report := m.Run(TriggerGRPC, CommitsTask([]string{"o/r"}, 10))
+	if report.Status != SyncStatusFailed {
+		t.Errorf("report status = %s, want failed", report.Status)
+	}
+	stored, err := m.GetJob(job.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Status != SyncStatusFailed || stored.Failed != 1 || len(stored.Errors) != 1 {
+		t.Errorf("stored job = %+v, want one failure", stored)
+	}
+}
+
+func TestJobManagerStartAndCancel(t *testing.T) {
+	started := make(chan struct{}, 1)
+	g, db, _ := newTestService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Hang until the client gives up
+		started <- struct{}{}
+		<-r.Context().Done()
+	}))
+	m := NewJobManager(g, db)
+	defer m.Shutdown()
+
+	job := m.Start(TriggerHTTP, CommitsTask([]string{"o/r"}, 10))
+	if job.ID == 0 || job.Status != JobStatusRunning {
+		t.Fatalf("started job = %+v", job)
+	}
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("job did not start")
+	}
+	running, err := m.GetJob(job.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if running.Status != JobStatusRunning || running.Done != 0 || !reflect.DeepEqual(running.Current, []string{"o/r"}) {
+		t.Errorf("running job = %+v, want o/r in progress", running)
+	}
+
+	if err := m.Cancel(job.ID); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for running.Status == JobStatusRunning {
+		if time.Now().After(deadline) {
+			t.Fatal("job did not stop after Cancel")
+		}
+		time.Sleep(10 * time.Millisecond)
+		if running, err = m.GetJob(job.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if running.Status != SyncStatusCanceled || running.FinishedAt == nil || len(running.Current) != 0 {
+		t.Errorf("canceled job = %+v", running)
+	}
+
+	if err := m.Cancel(job.ID); err != ErrJobNotRunning {
+		t.Errorf("second Cancel: err = %v, want ErrJobNotRunning", err)
+	}
+	if err := m.Cancel(job.ID + 1); err == nil || err == ErrJobNotRunning {
+		t.Errorf("Cancel of an unknown job: err = %v", err)
+	}
+}
+
+func TestJobManagerShutdown(t *testing.T) {
+	started := make(chan struct{}, 1)
+	g, db, _ := newTestService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		<-r.Context().Done()
+	}))
+	m := NewJobManager(g, db)
+
+	job := m.Start(TriggerScheduler, CommitsTask([]string{"o/r"}, 10))
+	<-started
+	m.Shutdown()
+
+	stored, err := db.GetSyncJob(job.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Status != SyncStatusCanceled {
+		t.Errorf("job status after Shutdown = %s, want canceled", stored.Status)
+	}
+
+	// Jobs started after the shutdown are canceled right away
+	_, report := m.Run(TriggerScheduler, CommitsTask([]string{"o/r"}, 10))
+	if report.Status != SyncStatusCanceled {
+		t.Errorf("job status after Shutdown = %s, want canceled", report.Status)
+	}
+}
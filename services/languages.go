@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	"twt/models"
+)
+
+// GetLanguages fetches the number of bytes per language of a repository. It
+// returns ErrNotModified if the breakdown is unchanged since the last call.
+func (g *GitHubService) GetLanguages(ctx context.Context, repositoryFullName string) ([]*models.Language, error) {
+	apiURL := g.repositoryAPIURL(repositoryFullName) + "/languages"
+	resp, err := g.getCached(ctx, apiURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get languages: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var githubLanguages map[string]int64
+	if err := json.NewDecoder(resp.Body).Decode(&githubLanguages); err != nil {
+		g.dropValidators(apiURL)
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	var languages []*models.Language
+	for name, bytes := range githubLanguages {
+		languages = append(languages, &models.Language{
+			RepositoryFullName: repositoryFullName,
+			Name:               name,
+			Bytes:              bytes,
+		})
+	}
+	return languages, nil
+}
+
+// syncLanguages replaces the stored language breakdown of a repository unless
+// it is unchanged.
+func (g *GitHubService) syncLanguages(ctx context.Context, repositoryFullName string, db *models.DB) error {
+	languages, err := g.GetLanguages(ctx, repositoryFullName)
+	if errors.Is(err, ErrNotModified) {
+		log.Printf("Languages unchanged: %s\n", repositoryFullName)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := db.ReplaceLanguages(repositoryFullName, languages); err != nil {
+		g.dropValidators(g.repositoryAPIURL(repositoryFullName) + "/languages")
+		return fmt.Errorf("failed to save languages: %w", err)
+	}
+
+	log.Printf("Successfully synced %d languages for repository: %s\n", len(languages), repositoryFullName)
+	return nil
+}
@@ -0,0 +1,46 @@
+package services
+
+import (
+	"log"
+	"time"
+
+	"twt/models"
+)
+
+// defaultMetricsRange is the range of a metrics series without from.
+const defaultMetricsRange = 30 * 24 * time.Hour
+
+// recordMetrics appends a metrics snapshot of a synced repository. A missing
+// snapshot only leaves a gap in the series, so it does not fail the sync.
+func (g *GitHubService) recordMetrics(fullName string, db *models.DB) {
+	if err := db.RecordMetricsSnapshot(fullName); err != nil {
+		log.Printf("Failed to record metrics of %s: %v\n", fullName, err)
+	}
+}
+
+// ParseDateRange parses the from and to of a metrics series, each YYYY-MM-DD
+// or RFC3339. to defaults to now and includes the whole day if it is a date;
+// from defaults to 30 days before to.
+func ParseDateRange(fromParam, toParam string) (time.Time, time.Time, error) {
+	to := time.Now()
+	if toParam != "" {
+		t, err := ParseSince(toParam)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		to = t
+		if _, err := time.Parse("2006-01-02", toParam); err == nil {
+			to = to.AddDate(0, 0, 1)
+		}
+	}
+
+	from := to.Add(-defaultMetricsRange)
+	if fromParam != "" {
+		t, err := ParseSince(fromParam)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		from = t
+	}
+	return from, to, nil
+}
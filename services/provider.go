@@ -0,0 +1,285 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"twt/config"
+	"twt/models"
+)
+
+// Provider fetches repositories from a code hosting service. Full names of
+// repositories outside github.com are qualified with their host, e.g.
+// gitea.com/gitea/tea, and the models returned carry that qualified name.
+type Provider interface {
+	// GetRepositoryInfo fetches repository metadata. The GitHub provider may
+	// return ErrNotModified.
+	GetRepositoryInfo(ctx context.Context, fullName string) (*models.Repository, error)
+	// GetCommits fetches the newest commits of the default branch.
+	GetCommits(ctx context.Context, fullName string, limit int) ([]*models.Commit, error)
+	// GetReleases fetches all releases. The GitHub provider may return
+	// ErrNotModified.
+	GetReleases(ctx context.Context, fullName string) ([]*models.Release, error)
+}
+
+var (
+	_ Provider = (*GitHubService)(nil)
+	_ Provider = (*GitLabProvider)(nil)
+	_ Provider = (*GiteaProvider)(nil)
+)
+
+// defaultAPIPaths are the REST API paths of the provider types, relative to
+// the web host.
+var defaultAPIPaths = map[string]string{
+	"github":  "/api/v3",
+	"gitlab":  "/api/v4",
+	"gitea":   "/api/v1",
+	"forgejo": "/api/v1",
+}
+
+// newProviders creates the providers of the configured hosts.
+func (g *GitHubService) newProviders(cfgs []config.ProviderConfig) (map[string]Provider, error) {
+	providers := make(map[string]Provider)
+	for _, cfg := range cfgs {
+		host := strings.ToLower(cfg.Host)
+		if !isHost(host) {
+			return nil, fmt.Errorf("invalid provider host %q", cfg.Host)
+		}
+		if _, ok := providers[host]; ok || host == "github.com" {
+			return nil, fmt.Errorf("duplicate provider host %q", cfg.Host)
+		}
+
+		apiURL := strings.TrimSuffix(cfg.APIURL, "/")
+		if apiURL == "" {
+			apiURL = "https://" + host + defaultAPIPaths[cfg.Type]
+		}
+
+		client := &http.Client{Timeout: 30 * time.Second}
+		switch cfg.Type {
+		case "github":
+			providers[host] = g.enterprise(host, apiURL, cfg.Token)
+		case "gitlab":
+			providers[host] = NewGitLabProvider(host, apiURL, cfg.Token, client)
+		case "gitea", "forgejo":
+			providers[host] = NewGiteaProvider(host, apiURL, cfg.Token, client)
+		default:
+			return nil, fmt.Errorf("unknown provider type %q for %s", cfg.Type, cfg.Host)
+		}
+	}
+	return providers, nil
+}
+
+// enterprise creates the service of a GitHub Enterprise Server instance. It
+// shares the settings of g but has its own token and rate limit.
+func (g *GitHubService) enterprise(host, apiURL, token string) *GitHubService {
+	return &GitHubService{
+		tokens:        newTokenPool([]string{token}, g.rate.maxWait),
+		apiURL:        apiURL,
+		host:          host,
+		client:        g.client,
+		cache:         g.cache,
+		rate:          &rateLimiter{maxWait: g.rate.maxWait},
+		maxRetries:    g.maxRetries,
+		concurrency:   g.concurrency,
+		branches:      g.branches,
+		commitDetails: g.commitDetails,
+	}
+}
+
+// githubFor returns the GitHub service of a repository: g for github.com or
+// the service of a GitHub Enterprise Server host. It returns false for
+// repositories of other providers.
+func (g *GitHubService) githubFor(fullName string) (*GitHubService, bool) {
+	host, _ := splitRepositoryHost(fullName)
+	if host == g.host {
+		return g, true
+	}
+	gh, ok := g.providers[host].(*GitHubService)
+	return gh, ok
+}
+
+// provider returns the provider of a repository outside github.com.
+func (g *GitHubService) provider(fullName string) (Provider, error) {
+	host, _ := splitRepositoryHost(fullName)
+	p, ok := g.providers[host]
+	if !ok {
+		return nil, fmt.Errorf("no provider configured for %s", host)
+	}
+	return p, nil
+}
+
+// syncProviderRepository syncs the metadata and releases of a repository of
+// a provider other than GitHub. The GitHub specific details (branches,
+// issues, Actions, ...) are not synced for such repositories.
+func (g *GitHubService) syncProviderRepository(ctx context.Context, repoURL string, db *models.DB) *RepoSyncResult {
+	result := &RepoSyncResult{Repository: repoURL, Status: SyncStatusSuccess}
+	fail := func(err error) *RepoSyncResult {
+		result.Status = SyncStatusFailed
+		result.Error = err.Error()
+		log.Printf("Failed to sync repository %s: %s\n", repoURL, result.Error)
+		return result
+	}
+
+	urlName, err := g.parseRepositoryURL(repoURL)
+	if err != nil {
+		return fail(err)
+	}
+	result.Repository = urlName
+	p, err := g.provider(urlName)
+	if err != nil {
+		return fail(err)
+	}
+
+	repo, err := p.GetRepositoryInfo(ctx, urlName)
+	if err != nil {
+		return fail(err)
+	}
+	// Stored under the name returned by the API, see syncRepository
+	fullName := repo.FullName
+	result.Repository = fullName
+	unlock := g.lockRepository(fullName)
+	defer unlock()
+
+	exists, err := db.HasRepository(fullName)
+	if err != nil {
+		return fail(err)
+	}
+	if err := db.SaveRepository(repo); err != nil {
+		return fail(fmt.Errorf("failed to save repository %s: %w", fullName, err))
+	}
+	if exists {
+		result.Updated = 1
+	} else {
+		result.Added = 1
+	}
+	g.recordMetrics(fullName, db)
+
+	releases, err := p.GetReleases(ctx, fullName)
+	if err != nil {
+		return fail(err)
+	}
+	if err := db.ReplaceReleases(fullName, releases); err != nil {
+		return fail(fmt.Errorf("failed to save releases: %w", err))
+	}
+
+	log.Printf("Successfully synced repository: %s (%d releases)\n", fullName, len(releases))
+	return result
+}
+
+// syncProviderCommits stores the newest commits of a repository of a
+// provider other than GitHub that are not stored yet.
+func (g *GitHubService) syncProviderCommits(ctx context.Context, fullName string, limit int, db *models.DB, observe SyncObserver) *RepoSyncResult {
+	result := &RepoSyncResult{Repository: fullName, Status: SyncStatusSuccess}
+	fail := func(err error) *RepoSyncResult {
+		log.Printf("Failed to sync commits of %s: %v\n", fullName, err)
+		result.Status = SyncStatusFailed
+		result.Error = err.Error()
+		return result
+	}
+
+	p, err := g.provider(fullName)
+	if err != nil {
+		return fail(err)
+	}
+	unlock := g.lockRepository(fullName)
+	defer unlock()
+
+	commits, err := p.GetCommits(ctx, fullName, limit)
+	if err != nil {
+		return fail(fmt.Errorf("failed to get commits: %w", err))
+	}
+	for _, commit := range commits {
+		known, err := db.HasCommit(commit.SHA, fullName)
+		if err != nil {
+			return fail(fmt.Errorf("failed to check commit %s: %w", commit.SHA, err))
+		}
+		if known {
+			result.Unchanged++
+			continue
+		}
+		if err := db.SaveCommit(commit); err != nil {
+			log.Printf("Failed to save commit %s: %v\n", commit.SHA, err)
+			continue
+		}
+		result.Added++
+	}
+	observe.commitsSaved(fullName, result.Added)
+
+	log.Printf("Successfully synced [%s] %d new commits (%d known)\n", fullName, result.Added, result.Unchanged)
+	return result
+}
+
+// apiClient performs authenticated GET requests against the REST API of a
+// provider other than GitHub.
+type apiClient struct {
+	name    string // used in errors, e.g. GitLab
+	baseURL string // e.g. https://gitlab.com/api/v4
+	header  string // authorization header
+	token   string // header value, empty for anonymous access
+	client  *http.Client
+}
+
+// get requests apiURL. The caller must close the response body.
+func (c *apiClient) get(ctx context.Context, apiURL string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if c.token != "" {
+		req.Header.Set(c.header, c.token)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%w: %s API error: %d - %s", ErrNotFound, c.name, resp.StatusCode, string(body))
+		}
+		return nil, fmt.Errorf("%s API error: %d - %s", c.name, resp.StatusCode, string(body))
+	}
+	return resp, nil
+}
+
+// getJSON requests apiURL and decodes the response into v.
+func (c *apiClient) getJSON(ctx context.Context, apiURL string, v any) error {
+	resp, err := c.get(ctx, apiURL)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// getAllPages requests apiURL and every following page, passing each
+// response body to decode. Both GitLab and Gitea link the next page in the
+// Link header.
+func (c *apiClient) getAllPages(ctx context.Context, apiURL string, decode func(io.Reader) error) error {
+	for page := 1; apiURL != ""; page++ {
+		resp, err := c.get(ctx, apiURL)
+		if err != nil {
+			return fmt.Errorf("page %d: %w", page, err)
+		}
+		err = decode(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("failed to read page %d: %w", page, err)
+		}
+		apiURL = nextPageURL(resp.Header.Get("Link"))
+	}
+	return nil
+}
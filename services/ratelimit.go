@@ -0,0 +1,257 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrRateLimited is returned when the quota is exhausted and the reset is
+// further away than the configured maximum wait.
+var ErrRateLimited = errors.New("GitHub rate limit exceeded")
+
+// RateLimit is the last known API quota as reported by GitHub.
+type RateLimit struct {
+	Limit     int       `json:"limit"`
+	Remaining int       `json:"remaining"`
+	Used      int       `json:"used"`
+	Reset     time.Time `json:"reset"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// rateLimiter tracks the X-RateLimit-* headers of every response and blocks
+// new requests while the quota is exhausted.
+type rateLimiter struct {
+	mu      sync.Mutex
+	state   RateLimit
+	maxWait time.Duration
+}
+
+func (r *rateLimiter) update(h http.Header) {
+	remaining := h.Get("X-RateLimit-Remaining")
+	if remaining == "" {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.state.Remaining, _ = strconv.Atoi(remaining)
+	r.state.Limit, _ = strconv.Atoi(h.Get("X-RateLimit-Limit"))
+	r.state.Used, _ = strconv.Atoi(h.Get("X-RateLimit-Used"))
+	if reset, err := strconv.ParseInt(h.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		r.state.Reset = time.Unix(reset, 0)
+	}
+	r.state.UpdatedAt = time.Now()
+}
+
+func (r *rateLimiter) get() RateLimit {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.state
+}
+
+// wait blocks until the quota resets if it is known to be exhausted. Waits
+// longer than maxWait are refused so callers can reschedule instead.
+func (r *rateLimiter) wait(ctx context.Context) error {
+	state := r.get()
+	if state.UpdatedAt.IsZero() || state.Remaining > 0 {
+		return nil
+	}
+
+	delay := time.Until(state.Reset)
+	if delay <= 0 {
+		return nil
+	}
+	if delay > r.maxWait {
+		return fmt.Errorf("%w: resets at %s", ErrRateLimited, state.Reset.Format(time.RFC3339))
+	}
+
+	log.Printf("GitHub rate limit exhausted, pausing for %s\n", delay.Round(time.Second))
+	return sleepContext(ctx, delay)
+}
+
+// sleepContext pauses for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// retryDelay decides whether a response should be retried and how long to
+// wait before doing so. Secondary rate limits are signalled by 403/429 with a
+// Retry-After header or an exhausted quota; 5xx responses are transient.
+func (r *rateLimiter) retryDelay(resp *http.Response, attempt int) (time.Duration, bool) {
+	backoff := time.Duration(1<<uint(attempt)) * time.Second
+
+	if resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests {
+		if after, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+			return time.Duration(after) * time.Second, true
+		}
+		if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+			if reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+				return time.Until(time.Unix(reset, 0)) + time.Second, true
+			}
+		}
+		// Secondary rate limits without headers: wait at least a minute
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		if isRateLimitBody(body) {
+			return time.Minute * time.Duration(1<<uint(attempt)), true
+		}
+		return 0, false
+	}
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return backoff, true
+	}
+	return 0, false
+}
+
+// do sends a request with the given credential, pausing while its quota is
+// exhausted and retrying rate limited and 5xx responses with exponential
+// backoff. A pooled token rejected with 401 is taken out of rotation and the
+// request is repeated with the next token. A rejected installation token is
+// dropped from the cache and the request is repeated once with a new one.
+func (g *GitHubService) do(req *http.Request, cred credential) (*http.Response, error) {
+	ctx := req.Context()
+	renewed := false
+	for attempt := 0; ; attempt++ {
+		req.Header.Del("Authorization")
+		if cred.header != "" {
+			req.Header.Set("Authorization", cred.header)
+		}
+		if err := cred.rate.wait(ctx); err != nil {
+			return nil, err
+		}
+
+		resp, err := g.client.Do(req)
+		if err != nil {
+			if attempt < g.maxRetries && ctx.Err() == nil {
+				delay := time.Duration(1<<uint(attempt)) * time.Second
+				log.Printf("GitHub request %s failed, retrying in %s: %v\n", req.URL, delay, err)
+				if err := sleepContext(ctx, delay); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			return nil, fmt.Errorf("failed to make request: %w", err)
+		}
+		cred.rate.update(resp.Header)
+
+		if resp.StatusCode == http.StatusUnauthorized && cred.token != nil {
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+			g.tokens.invalidate(cred.token, fmt.Sprintf("%d - %s", resp.StatusCode, errorMessage(body)))
+			if next := g.credential(ctx, req.URL.String()); next.token != nil {
+				cred = next
+				continue
+			}
+			return resp, nil
+		}
+		if resp.StatusCode == http.StatusUnauthorized && cred.owner != "" && !renewed {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+			g.app.invalidate(cred.owner, cred.value)
+			renewed = true
+			cred = g.credential(ctx, req.URL.String())
+			continue
+		}
+
+		delay, retry := cred.rate.retryDelay(resp, attempt)
+		if !retry || attempt >= g.maxRetries {
+			return resp, nil
+		}
+		if delay > cred.rate.maxWait {
+			resp.Body.Close()
+			return nil, fmt.Errorf("%w: retry after %s", ErrRateLimited, delay.Round(time.Second))
+		}
+
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		log.Printf("GitHub API returned %d for %s, retrying in %s\n", resp.StatusCode, req.URL, delay.Round(time.Second))
+		if err := sleepContext(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// FetchRateLimit queries the /rate_limit endpoint. With several tokens the
+// quota of the token with the most remaining requests is reported.
+func (g *GitHubService) FetchRateLimit(ctx context.Context) (RateLimit, error) {
+	cred := g.credential(ctx, g.apiURL+"/rate_limit")
+	resp, err := g.requestRateLimit(ctx, cred.header)
+	if err != nil {
+		return RateLimit{}, err
+	}
+	defer resp.Body.Close()
+	cred.rate.update(resp.Header)
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return RateLimit{}, fmt.Errorf("GitHub API error: %d - %s", resp.StatusCode, string(body))
+	}
+
+	var body struct {
+		Resources struct {
+			Core struct {
+				Limit     int   `json:"limit"`
+				Remaining int   `json:"remaining"`
+				Used      int   `json:"used"`
+				Reset     int64 `json:"reset"`
+			} `json:"core"`
+		} `json:"resources"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return RateLimit{}, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	core := body.Resources.Core
+	return RateLimit{
+		Limit:     core.Limit,
+		Remaining: core.Remaining,
+		Used:      core.Used,
+		Reset:     time.Unix(core.Reset, 0),
+		UpdatedAt: time.Now(),
+	}, nil
+}
+
+// requestRateLimit requests /rate_limit with the given Authorization header.
+// The endpoint does not count against the quota, so the request bypasses the
+// limiter and is not retried: it must answer while the quota is exhausted.
+func (g *GitHubService) requestRateLimit(ctx context.Context, authorization string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", g.apiURL+"/rate_limit", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	req.Header.Set("Accept", jsonMediaType)
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	return resp, nil
+}
+
+// isRateLimitBody reports whether an error body mentions a rate limit.
+func isRateLimitBody(body []byte) bool {
+	return strings.Contains(strings.ToLower(string(body)), "rate limit")
+}
@@ -0,0 +1,122 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetryDelay(t *testing.T) {
+	reset := time.Now().Add(90 * time.Second)
+
+	tests := []struct {
+		name      string
+		status    int
+		header    map[string]string
+		body      string
+		attempt   int
+		wantRetry bool
+		min, max  time.Duration
+	}{
+		{name: "ok", status: http.StatusOK},
+		{name: "not found", status: http.StatusNotFound},
+		{name: "unauthorized", status: http.StatusUnauthorized},
+		{
+			name: "retry after", status: http.StatusForbidden,
+			header:    map[string]string{"Retry-After": "30"},
+			wantRetry: true, min: 30 * time.Second, max: 30 * time.Second,
+		},
+		{
+			name: "too many requests", status: http.StatusTooManyRequests,
+			header:    map[string]string{"Retry-After": "5"},
+			wantRetry: true, min: 5 * time.Second, max: 5 * time.Second,
+		},
+		{
+			name: "quota exhausted", status: http.StatusForbidden,
+			header: map[string]string{
+				"X-RateLimit-Remaining": "0",
+				"X-RateLimit-Reset":     strconv.FormatInt(reset.Unix(), 10),
+			},
+			wantRetry: true, min: 85 * time.Second, max: 92 * time.Second,
+		},
+		{
+			name: "secondary rate limit", status: http.StatusForbidden,
+			body:      `{"message":"You have exceeded a secondary rate limit."}`,
+			attempt:   1,
+			wantRetry: true, min: 2 * time.Minute, max: 2 * time.Minute,
+		},
+		{
+			name: "forbidden", status: http.StatusForbidden,
+			body: `{"message":"Resource not accessible by integration"}`,
+		},
+		{
+			name: "server error", status: http.StatusBadGateway,
+			wantRetry: true, min: time.Second, max: time.Second,
+		},
+		{
+			name: "server error backoff", status: http.StatusServiceUnavailable, attempt: 2,
+			wantRetry: true, min: 4 * time.Second, max: 4 * time.Second,
+		},
+	}
+
+	r := &rateLimiter{maxWait: time.Hour}
+	for _, tt := range tests {
+		resp := &http.Response{
+			StatusCode: tt.status,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader(tt.body)),
+		}
+		for k, v := range tt.header {
+			resp.Header.Set(k, v)
+		}
+
+		delay, retry := r.retryDelay(resp, tt.attempt)
+		if retry != tt.wantRetry {
+			t.Errorf("%s: retry = %v, want %v", tt.name, retry, tt.wantRetry)
+			continue
+		}
+		if delay < tt.min || delay > tt.max {
+			t.Errorf("%s: delay = %s, want between %s and %s", tt.name, delay, tt.min, tt.max)
+		}
+
+		// The body must still be readable by the caller
+		if body, _ := io.ReadAll(resp.Body); string(body) != tt.body {
+			t.Errorf("%s: body = %q, want %q", tt.name, body, tt.body)
+		}
+	}
+}
+
+func TestFetchRateLimitIgnoresExhaustedQuota(t *testing.T) {
+	reset := time.Now().Add(time.Hour)
+	g, _, _ := newTestService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rate_limit" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		fmt.Fprintf(w, `{"resources":{"core":{"limit":5000,"remaining":0,"used":5000,"reset":%d}}}`, reset.Unix())
+	}))
+
+	// An exhausted quota would make other requests wait for the reset
+	h := make(http.Header)
+	h.Set("X-RateLimit-Remaining", "0")
+	h.Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+	g.rate.update(h)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	rateLimit, err := g.FetchRateLimit(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rateLimit.Limit != 5000 || rateLimit.Remaining != 0 {
+		t.Errorf("rate limit = %+v", rateLimit)
+	}
+}
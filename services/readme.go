@@ -0,0 +1,115 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	"twt/models"
+)
+
+// htmlMediaType requests file contents rendered as HTML.
+const htmlMediaType = "application/vnd.github.html"
+
+// GitHubReadme is the response of /readme. Content is base64 encoded.
+type GitHubReadme struct {
+	Path     string `json:"path"`
+	SHA      string `json:"sha"`
+	Content  string `json:"content"`
+	Encoding string `json:"encoding"`
+	HTMLURL  string `json:"html_url"`
+}
+
+// GetReadmeHTML fetches the README of a repository rendered by GitHub.
+func (g *GitHubService) GetReadmeHTML(ctx context.Context, repositoryFullName string) (string, error) {
+	resp, err := g.fetch(ctx, g.repositoryAPIURL(repositoryFullName)+"/readme", htmlMediaType, false)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	html, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response: %w", err)
+	}
+	return string(html), nil
+}
+
+// syncReadme caches the README of a repository. The markdown and HTML are
+// only downloaded when the SHA differs from the cached one; a README that no
+// longer exists is removed.
+func (g *GitHubService) syncReadme(ctx context.Context, repositoryFullName string, db *models.DB) error {
+	stored, err := db.GetReadme(repositoryFullName)
+	if err != nil {
+		return fmt.Errorf("failed to get stored README: %w", err)
+	}
+
+	apiURL := g.repositoryAPIURL(repositoryFullName) + "/readme"
+	resp, err := g.getCached(ctx, apiURL)
+	if errors.Is(err, ErrNotModified) {
+		if stored != nil {
+			return db.TouchReadme(repositoryFullName)
+		}
+		// The row is gone but the validators remain, fetch the full response
+		g.dropValidators(apiURL)
+		resp, err = g.get(ctx, apiURL)
+	}
+	if errors.Is(err, ErrNotFound) {
+		if err := db.DeleteReadme(repositoryFullName); err != nil {
+			return fmt.Errorf("failed to delete README: %w", err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get README: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Drop the validators on failure so the next sync does not get a 304 for
+	// a README that was never stored
+	fail := func(err error) error {
+		g.dropValidators(apiURL)
+		return err
+	}
+
+	var githubReadme GitHubReadme
+	if err := json.NewDecoder(resp.Body).Decode(&githubReadme); err != nil {
+		return fail(fmt.Errorf("failed to decode README: %w", err))
+	}
+	if stored != nil && stored.SHA == githubReadme.SHA {
+		return db.TouchReadme(repositoryFullName)
+	}
+
+	if githubReadme.Encoding != "base64" {
+		return fail(fmt.Errorf("unsupported README encoding %q", githubReadme.Encoding))
+	}
+	// GitHub wraps the encoded content every 60 characters
+	content, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(githubReadme.Content, "\n", ""))
+	if err != nil {
+		return fail(fmt.Errorf("failed to decode README content: %w", err))
+	}
+	html, err := g.GetReadmeHTML(ctx, repositoryFullName)
+	if err != nil {
+		return fail(fmt.Errorf("failed to get README HTML: %w", err))
+	}
+
+	readme := &models.Readme{
+		RepositoryFullName: repositoryFullName,
+		Path:               githubReadme.Path,
+		SHA:                githubReadme.SHA,
+		Content:            string(content),
+		HTML:               html,
+		URL:                githubReadme.HTMLURL,
+	}
+	if err := db.SaveReadme(readme); err != nil {
+		return fail(fmt.Errorf("failed to save README: %w", err))
+	}
+
+	log.Printf("Successfully synced README for repository: %s\n", repositoryFullName)
+	return nil
+}
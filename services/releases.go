@@ -0,0 +1,191 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"twt/models"
+)
+
+type GitHubRelease struct {
+	ID          int64      `json:"id"`
+	TagName     string     `json:"tag_name"`
+	Name        *string    `json:"name"`
+	Body        *string    `json:"body"`
+	Draft       bool       `json:"draft"`
+	Prerelease  bool       `json:"prerelease"`
+	HTMLURL     string     `json:"html_url"`
+	CreatedAt   time.Time  `json:"created_at"`
+	PublishedAt *time.Time `json:"published_at"`
+	Author      struct {
+		Login string `json:"login"`
+	} `json:"author"`
+}
+
+type GitHubTag struct {
+	Name   string `json:"name"`
+	Commit struct {
+		SHA string `json:"sha"`
+	} `json:"commit"`
+}
+
+// toRelease converts a release of the GitHub (or Gitea) API to our model.
+func (gr GitHubRelease) toRelease(repositoryFullName string) *models.Release {
+	release := &models.Release{
+		ReleaseID:          gr.ID,
+		RepositoryFullName: repositoryFullName,
+		TagName:            gr.TagName,
+		Draft:              gr.Draft,
+		Prerelease:         gr.Prerelease,
+		Author:             gr.Author.Login,
+		URL:                gr.HTMLURL,
+		CreatedAt:          gr.CreatedAt,
+		PublishedAt:        gr.PublishedAt,
+	}
+	if gr.Name != nil {
+		release.Name = *gr.Name
+	}
+	if gr.Body != nil {
+		release.Body = *gr.Body
+	}
+	return release
+}
+
+// getAllPages requests apiURL and every following page, passing each
+// response body to decode.
+func (g *GitHubService) getAllPages(ctx context.Context, apiURL string, decode func(io.Reader) error) error {
+	return g.getPages(ctx, apiURL, false, decode)
+}
+
+// getAllPagesCached is like getAllPages but requests the first page
+// conditionally and returns ErrNotModified if it is unchanged. Validators are
+// only kept for lists that fit on a single page, as a change on a later page
+// does not show on the first one. Callers that fail to store the list must
+// drop them with dropValidators.
+func (g *GitHubService) getAllPagesCached(ctx context.Context, apiURL string, decode func(io.Reader) error) error {
+	return g.getPages(ctx, apiURL, true, decode)
+}
+
+func (g *GitHubService) getPages(ctx context.Context, apiURL string, conditional bool, decode func(io.Reader) error) error {
+	ctx = withOwner(ctx, g.urlOwner(apiURL))
+	firstURL := apiURL
+	fail := func(err error) error {
+		if conditional {
+			g.dropValidators(firstURL)
+		}
+		return err
+	}
+
+	for page := 1; apiURL != ""; page++ {
+		resp, err := g.fetch(ctx, apiURL, jsonMediaType, conditional && page == 1)
+		if errors.Is(err, ErrNotModified) {
+			return err
+		}
+		if err != nil {
+			return fail(fmt.Errorf("page %d: %w", page, err))
+		}
+		if resp.StatusCode != http.StatusNoContent {
+			err = decode(resp.Body)
+		}
+		resp.Body.Close()
+		if err != nil {
+			return fail(fmt.Errorf("failed to read page %d: %w", page, err))
+		}
+		apiURL = nextPageURL(resp.Header.Get("Link"))
+		if conditional && page == 1 && apiURL != "" {
+			g.dropValidators(firstURL)
+		}
+	}
+	return nil
+}
+
+func (g *GitHubService) releasesAPIURL(repositoryFullName string) string {
+	return fmt.Sprintf("%s/releases?per_page=%d", g.repositoryAPIURL(repositoryFullName), maxPerPage)
+}
+
+func (g *GitHubService) tagsAPIURL(repositoryFullName string) string {
+	return fmt.Sprintf("%s/tags?per_page=%d", g.repositoryAPIURL(repositoryFullName), maxPerPage)
+}
+
+// GetReleases fetches all releases of a repository, following pagination. It
+// returns ErrNotModified if the list is unchanged since the last call.
+func (g *GitHubService) GetReleases(ctx context.Context, repositoryFullName string) ([]*models.Release, error) {
+	var releases []*models.Release
+	err := g.getAllPagesCached(ctx, g.releasesAPIURL(repositoryFullName), func(body io.Reader) error {
+		var githubReleases []GitHubRelease
+		if err := json.NewDecoder(body).Decode(&githubReleases); err != nil {
+			return err
+		}
+		for _, gr := range githubReleases {
+			releases = append(releases, gr.toRelease(repositoryFullName))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get releases: %w", err)
+	}
+	return releases, nil
+}
+
+// GetTags fetches all tags of a repository, following pagination. It returns
+// ErrNotModified if the list is unchanged since the last call.
+func (g *GitHubService) GetTags(ctx context.Context, repositoryFullName string) ([]*models.Tag, error) {
+	var tags []*models.Tag
+	err := g.getAllPagesCached(ctx, g.tagsAPIURL(repositoryFullName), func(body io.Reader) error {
+		var githubTags []GitHubTag
+		if err := json.NewDecoder(body).Decode(&githubTags); err != nil {
+			return err
+		}
+		for _, gt := range githubTags {
+			tags = append(tags, &models.Tag{
+				RepositoryFullName: repositoryFullName,
+				Name:               gt.Name,
+				CommitSHA:          gt.Commit.SHA,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+	return tags, nil
+}
+
+// syncReleases replaces the stored releases and tags of a repository with the
+// current lists from GitHub. Unchanged lists are not replaced.
+func (g *GitHubService) syncReleases(ctx context.Context, repositoryFullName string, db *models.DB) error {
+	releases, err := g.GetReleases(ctx, repositoryFullName)
+	switch {
+	case errors.Is(err, ErrNotModified):
+		log.Printf("Releases unchanged: %s\n", repositoryFullName)
+	case err != nil:
+		return err
+	default:
+		if err := db.ReplaceReleases(repositoryFullName, releases); err != nil {
+			g.dropValidators(g.releasesAPIURL(repositoryFullName))
+			return fmt.Errorf("failed to save releases: %w", err)
+		}
+		log.Printf("Successfully synced %d releases for repository: %s\n", len(releases), repositoryFullName)
+	}
+
+	tags, err := g.GetTags(ctx, repositoryFullName)
+	switch {
+	case errors.Is(err, ErrNotModified):
+		log.Printf("Tags unchanged: %s\n", repositoryFullName)
+	case err != nil:
+		return err
+	default:
+		if err := db.ReplaceTags(repositoryFullName, tags); err != nil {
+			g.dropValidators(g.tagsAPIURL(repositoryFullName))
+			return fmt.Errorf("failed to save tags: %w", err)
+		}
+		log.Printf("Successfully synced %d tags for repository: %s\n", len(tags), repositoryFullName)
+	}
+	return nil
+}
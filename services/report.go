@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	SyncStatusSuccess  = "success"
+	SyncStatusPartial  = "partial" // report only: some repositories failed
+	SyncStatusFailed   = "failed"
+	SyncStatusCanceled = "canceled"
+)
+
+// Sync event types
+const (
+	EventRepositoryStarted  = "repository_started"
+	EventRepositoryFinished = "repository_finished"
+	EventRepositoryFailed   = "repository_failed"
+	EventCommitsSaved       = "commits_saved"
+	EventSyncFinished       = "sync_finished"
+)
+
+// SyncEvent reports the progress of a running sync.
+type SyncEvent struct {
+	Type       string
+	Repository string
+	Result     *RepoSyncResult // set for finished and failed events
+	Commits    int             // set for commits saved events: size of the batch
+}
+
+// SyncObserver receives sync events. It is called from the worker
+// goroutines and must be safe for concurrent use.
+type SyncObserver func(SyncEvent)
+
+func (o SyncObserver) emit(event SyncEvent) {
+	if o != nil {
+		o(event)
+	}
+}
+
+// commitsSaved reports a batch of stored commits. Empty batches are skipped.
+func (o SyncObserver) commitsSaved(repository string, count int) {
+	if count > 0 {
+		o.emit(SyncEvent{Type: EventCommitsSaved, Repository: repository, Commits: count})
+	}
+}
+
+// RepoSyncResult is the outcome of syncing a single repository.
+type RepoSyncResult struct {
+	Repository string        `json:"repository"`
+	Status     string        `json:"status"`
+	Error      string        `json:"error,omitempty"`
+	Warnings   []string      `json:"warnings,omitempty"` // failed detail steps, e.g. "actions: ..."
+	Added      int           `json:"added"`
+	Updated    int           `json:"updated"`
+	Unchanged  int           `json:"unchanged"`
+	Duration   time.Duration `json:"-"`
+	DurationMS int64         `json:"duration_ms"`
+}
+
+// SyncReport aggregates the per-repository results of a sync run. A run in
+// which some repositories fail is a partial success, not an error.
+type SyncReport struct {
+	Status     string            `json:"status"`
+	Results    []*RepoSyncResult `json:"results"`
+	Succeeded  int               `json:"succeeded"`
+	Failed     int               `json:"failed"`
+	Canceled   int               `json:"canceled"`
+	Added      int               `json:"added"`
+	Updated    int               `json:"updated"`
+	Unchanged  int               `json:"unchanged"`
+	StartedAt  time.Time         `json:"started_at"`
+	FinishedAt time.Time         `json:"finished_at"`
+}
+
+func (r *SyncReport) add(result *RepoSyncResult) {
+	switch result.Status {
+	case SyncStatusFailed:
+		r.Failed++
+	case SyncStatusCanceled:
+		r.Canceled++
+	default:
+		r.Succeeded++
+	}
+	r.Added += result.Added
+	r.Updated += result.Updated
+	r.Unchanged += result.Unchanged
+}
+
+// finish computes the aggregate status once all results are in.
+func (r *SyncReport) finish(ctx context.Context) {
+	r.FinishedAt = time.Now()
+	switch {
+	case ctx.Err() != nil:
+		r.Status = SyncStatusCanceled
+	case r.Failed == 0:
+		r.Status = SyncStatusSuccess
+	case r.Succeeded == 0:
+		r.Status = SyncStatusFailed
+	default:
+		r.Status = SyncStatusPartial
+	}
+}
@@ -0,0 +1,77 @@
+package services
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSchedule(t *testing.T) {
+	tests := []struct {
+		spec    string
+		wantErr bool
+	}{
+		{spec: "30m"},
+		{spec: "@every 2h"},
+		{spec: "@hourly"},
+		{spec: "@daily"},
+		{spec: "@weekly"},
+		{spec: "@monthly"},
+		{spec: "0 */6 * * *"},
+		{spec: "15,45 9-17 * * 1-5"},
+		{spec: "0 0 * * 7"},
+		{spec: "", wantErr: true},
+		{spec: "30s", wantErr: true},
+		{spec: "* * * *", wantErr: true},
+		{spec: "60 * * * *", wantErr: true},
+		{spec: "* 24 * * *", wantErr: true},
+		{spec: "* * 0 * *", wantErr: true},
+		{spec: "* * * 13 *", wantErr: true},
+		{spec: "* * * * 8", wantErr: true},
+		{spec: "*/0 * * * *", wantErr: true},
+		{spec: "5-1 * * * *", wantErr: true},
+		{spec: "a * * * *", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		_, err := ParseSchedule(tt.spec)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseSchedule(%q) error = %v, wantErr %v", tt.spec, err, tt.wantErr)
+		}
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	// 2024-01-10 is a Wednesday
+	from := time.Date(2024, 1, 10, 10, 20, 30, 0, time.UTC)
+
+	tests := []struct {
+		spec string
+		want time.Time
+	}{
+		{"30m", from.Add(30 * time.Minute)},
+		{"@every 2h", from.Add(2 * time.Hour)},
+		{"@hourly", time.Date(2024, 1, 10, 11, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2024, 1, 14, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2024, 1, 10, 10, 30, 0, 0, time.UTC)},
+		{"0 */6 * * *", time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)},
+		{"20 10 * * *", time.Date(2024, 1, 11, 10, 20, 0, 0, time.UTC)},
+		{"0 9 * * 1-5", time.Date(2024, 1, 11, 9, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2024, 1, 14, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 0 31 * *", time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)},
+		// Both day fields restricted: either may match
+		{"0 0 15 * 5", time.Date(2024, 1, 12, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		s, err := ParseSchedule(tt.spec)
+		if err != nil {
+			t.Fatalf("ParseSchedule(%q): %v", tt.spec, err)
+		}
+		if got := s.Next(from); !got.Equal(tt.want) {
+			t.Errorf("%q: Next(%s) = %s, want %s", tt.spec, from, got, tt.want)
+		}
+	}
+}
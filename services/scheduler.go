@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"sync"
+	"time"
+
+	"twt/config"
+)
+
+// Scheduler periodically syncs repository information and commits in the
+// background. Repositories sharing a schedule are synced together, while
+// repositories with an override run on their own timer. The repositories are
+// resolved on every run, so newly discovered ones are picked up.
+type Scheduler struct {
+	jobs        *JobManager
+	groups      []*scheduleGroup
+	defaultSpec string
+	overrides   map[string]config.ScheduleOverride // repository full name -> override
+	jitter      time.Duration
+	limit       int
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+type scheduleGroup struct {
+	spec     string
+	schedule Schedule
+}
+
+func NewScheduler(cfg config.SchedulerConfig, jobs *JobManager) (*Scheduler, error) {
+	s := &Scheduler{
+		jobs:      jobs,
+		limit:     cfg.CommitLimit,
+		overrides: make(map[string]config.ScheduleOverride),
+		stop:      make(chan struct{}),
+	}
+
+	if cfg.Jitter != "" {
+		jitter, err := time.ParseDuration(cfg.Jitter)
+		if err != nil {
+			return nil, fmt.Errorf("invalid jitter %q: %w", cfg.Jitter, err)
+		}
+		s.jitter = jitter
+	}
+
+	s.defaultSpec = cfg.Schedule
+	if s.defaultSpec == "" {
+		s.defaultSpec = "@every 1h"
+	}
+	defaultSchedule, err := ParseSchedule(s.defaultSpec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule: %w", err)
+	}
+	s.groups = append(s.groups, &scheduleGroup{spec: s.defaultSpec, schedule: defaultSchedule})
+
+	specs := map[string]bool{s.defaultSpec: true}
+	for _, o := range cfg.Overrides {
+		fullName, err := jobs.github.parseRepositoryURL(o.Repository)
+		if err != nil {
+			return nil, fmt.Errorf("invalid override: %w", err)
+		}
+		s.overrides[fullName] = o
+
+		if o.Disable || o.Schedule == "" || specs[o.Schedule] {
+			continue
+		}
+		schedule, err := ParseSchedule(o.Schedule)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule for %s: %w", fullName, err)
+		}
+		specs[o.Schedule] = true
+		s.groups = append(s.groups, &scheduleGroup{spec: o.Schedule, schedule: schedule})
+	}
+
+	return s, nil
+}
+
+// specOf returns the schedule of a repository, or "" if it is disabled.
+func (s *Scheduler) specOf(fullName string) string {
+	o, ok := s.overrides[fullName]
+	switch {
+	case !ok:
+		return s.defaultSpec
+	case o.Disable:
+		return ""
+	case o.Schedule != "":
+		return o.Schedule
+	default:
+		return s.defaultSpec
+	}
+}
+
+// repositories resolves the repositories currently on the schedule of group.
+func (s *Scheduler) repositories(group *scheduleGroup) []string {
+	var repoURLs []string
+	for _, repoURL := range s.jobs.github.ResolveRepositories(context.Background(), s.jobs.db) {
+		if s.specOf(s.jobs.github.repositoryName(repoURL)) == group.spec {
+			repoURLs = append(repoURLs, repoURL)
+		}
+	}
+	return repoURLs
+}
+
+// Start launches one goroutine per schedule group.
+func (s *Scheduler) Start() {
+	for _, group := range s.groups {
+		s.wg.Add(1)
+		go s.run(group)
+		log.Printf("Scheduler: started schedule %q", group.spec)
+	}
+}
+
+// Stop waits for in-flight runs to finish and stops all timers.
+func (s *Scheduler) Stop() {
+	close(s.stop)
+	s.wg.Wait()
+}
+
+func (s *Scheduler) run(group *scheduleGroup) {
+	defer s.wg.Done()
+
+	for {
+		next := group.schedule.Next(time.Now())
+		if next.IsZero() {
+			log.Printf("Scheduler: schedule %q never fires, stopping", group.spec)
+			return
+		}
+		if s.jitter > 0 {
+			next = next.Add(time.Duration(rand.Int63n(int64(s.jitter))))
+		}
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-s.stop:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		s.syncGroup(group)
+	}
+}
+
+func (s *Scheduler) syncGroup(group *scheduleGroup) {
+	start := time.Now()
+	repoURLs := s.repositories(group)
+	if len(repoURLs) == 0 {
+		log.Printf("Scheduler: no repositories on schedule %q", group.spec)
+		return
+	}
+	log.Printf("Scheduler: starting sync of %d repositories (%s)", len(repoURLs), group.spec)
+
+	_, repoReport := s.jobs.Run(TriggerScheduler, RepositoriesTask(repoURLs))
+	_, commitReport := s.jobs.Run(TriggerScheduler, CommitsTask(repoURLs, s.limit))
+
+	log.Printf("Scheduler: synced %d/%d repositories and %d new commits in %s",
+		repoReport.Succeeded, len(repoURLs), commitReport.Added, time.Since(start).Round(time.Second))
+}
@@ -0,0 +1,260 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Token health states
+const (
+	TokenStatusValid   = "valid"
+	TokenStatusInvalid = "invalid" // rejected by GitHub with 401
+)
+
+// TokenHealth reports the state of a personal access token of the pool. The
+// token itself is masked.
+type TokenHealth struct {
+	Host         string     `json:"host"`  // github.com or a GitHub Enterprise Server host
+	Token        string     `json:"token"` // last characters only, e.g. ...a1b2
+	Status       string     `json:"status"`
+	RateLimit    RateLimit  `json:"rate_limit"`
+	Requests     int64      `json:"requests"` // since startup
+	Error        string     `json:"error,omitempty"`
+	InvalidSince *time.Time `json:"invalid_since,omitempty"`
+}
+
+// poolToken is a personal access token with its own quota.
+type poolToken struct {
+	value string
+	rate  *rateLimiter
+
+	// Guarded by tokenPool.mu
+	requests     int64
+	invalidSince *time.Time
+	lastError    string
+}
+
+// tokenPool spreads requests over several personal access tokens. Every
+// request uses the token with the most remaining quota; tokens rejected with
+// 401 are skipped until a health check succeeds again.
+type tokenPool struct {
+	mu     sync.Mutex
+	tokens []*poolToken
+}
+
+func newTokenPool(tokens []string, maxWait time.Duration) *tokenPool {
+	p := &tokenPool{}
+	seen := make(map[string]bool)
+	for _, token := range tokens {
+		if token == "" || token == "your_github_token_here" || seen[token] {
+			continue
+		}
+		seen[token] = true
+		p.tokens = append(p.tokens, &poolToken{value: token, rate: &rateLimiter{maxWait: maxWait}})
+	}
+	return p
+}
+
+// available returns the quota a token can still spend. Tokens without a
+// known quota, or whose quota has reset since, count as unused.
+func (t *poolToken) available() int {
+	state := t.rate.get()
+	if state.UpdatedAt.IsZero() || time.Now().After(state.Reset) {
+		return math.MaxInt
+	}
+	return state.Remaining
+}
+
+// pick returns the token a request should use and counts the request.
+func (p *tokenPool) pick() *poolToken {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	t := p.bestLocked()
+	if t != nil {
+		t.requests++
+	}
+	return t
+}
+
+// bestLocked returns the valid token with the most remaining quota,
+// preferring the earliest reset if all are exhausted, or nil if no token is
+// valid.
+func (p *tokenPool) bestLocked() *poolToken {
+	var best *poolToken
+	bestAvailable := -1
+	for _, t := range p.tokens {
+		if t.invalidSince != nil {
+			continue
+		}
+		available := t.available()
+		if available > bestAvailable ||
+			(available == 0 && bestAvailable == 0 && t.rate.get().Reset.Before(best.rate.get().Reset)) {
+			best, bestAvailable = t, available
+		}
+	}
+	return best
+}
+
+// invalidate takes a token out of rotation.
+func (p *tokenPool) invalidate(t *poolToken, reason string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if t.invalidSince == nil {
+		now := time.Now()
+		t.invalidSince = &now
+		log.Printf("GitHub token %s was rejected and is no longer used: %s\n", maskToken(t.value), reason)
+	}
+	t.lastError = reason
+}
+
+// validate puts a token back into rotation.
+func (p *tokenPool) validate(t *poolToken) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	t.invalidSince = nil
+	t.lastError = ""
+}
+
+func (p *tokenPool) health(host string) []TokenHealth {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	health := make([]TokenHealth, 0, len(p.tokens))
+	for _, t := range p.tokens {
+		h := TokenHealth{
+			Host:         host,
+			Token:        maskToken(t.value),
+			Status:       TokenStatusValid,
+			RateLimit:    t.rate.get(),
+			Requests:     t.requests,
+			Error:        t.lastError,
+			InvalidSince: t.invalidSince,
+		}
+		if t.invalidSince != nil {
+			h.Status = TokenStatusInvalid
+		}
+		health = append(health, h)
+	}
+	return health
+}
+
+// maskToken returns the last four characters of a token for display.
+func maskToken(token string) string {
+	if len(token) <= 4 {
+		return "..."
+	}
+	return "..." + token[len(token)-4:]
+}
+
+// credential is the token a request is authorized with and the quota it
+// draws from.
+type credential struct {
+	header string       // Authorization header, empty for anonymous requests
+	rate   *rateLimiter // quota tracked from the response headers
+	token  *poolToken   // nil unless the token comes from the pool
+	owner  string       // owner of a GitHub App installation token, empty otherwise
+	value  string       // the installation token
+}
+
+// credential selects the authorization of a request: an installation token of
+// the GitHub App if it is installed for the owner of the requested
+// repository, organization or user, otherwise the pooled token with the most
+// remaining quota.
+func (g *GitHubService) credential(ctx context.Context, apiURL string) credential {
+	if g.app != nil {
+		owner := g.urlOwner(apiURL)
+		if owner == "" {
+			owner, _ = ctx.Value(ownerKey{}).(string)
+		}
+		if owner != "" {
+			t, err := g.app.token(ctx, owner)
+			if err != nil {
+				log.Printf("Failed to authenticate as GitHub App for %s, using the personal access token: %v\n", owner, err)
+			}
+			if t != nil && t.token != "" {
+				return credential{header: "token " + t.token, rate: t.rate, owner: owner, value: t.token}
+			}
+		}
+	}
+
+	if t := g.tokens.pick(); t != nil {
+		return credential{header: "token " + t.value, rate: t.rate, token: t}
+	}
+	return credential{rate: g.rate}
+}
+
+// CheckTokens queries the quota of every pooled token of github.com and the
+// GitHub Enterprise Server instances. Tokens rejected with 401 are taken out
+// of rotation, tokens that are accepted again are put back.
+func (g *GitHubService) CheckTokens(ctx context.Context) []TokenHealth {
+	health := g.checkTokens(ctx)
+
+	hosts := make([]string, 0, len(g.providers))
+	for host := range g.providers {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+	for _, host := range hosts {
+		if gh, ok := g.providers[host].(*GitHubService); ok {
+			health = append(health, gh.checkTokens(ctx)...)
+		}
+	}
+	return health
+}
+
+// checkTokens checks the tokens of the pool of a single instance.
+func (g *GitHubService) checkTokens(ctx context.Context) []TokenHealth {
+	host := g.host
+	if host == "" {
+		host = "github.com"
+	}
+	for _, t := range g.tokens.tokens {
+		if err := g.checkToken(ctx, t); err != nil {
+			log.Printf("Failed to check GitHub token %s of %s: %v\n", maskToken(t.value), host, err)
+		}
+	}
+	return g.tokens.health(host)
+}
+
+func (g *GitHubService) checkToken(ctx context.Context, t *poolToken) error {
+	resp, err := g.requestRateLimit(ctx, "token "+t.value)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	switch resp.StatusCode {
+	case http.StatusOK:
+		t.rate.update(resp.Header)
+		g.tokens.validate(t)
+		return nil
+	case http.StatusUnauthorized:
+		g.tokens.invalidate(t, fmt.Sprintf("%d - %s", resp.StatusCode, errorMessage(body)))
+		return nil
+	default:
+		return fmt.Errorf("GitHub API error: %d - %s", resp.StatusCode, string(body))
+	}
+}
+
+// errorMessage returns the message of a GitHub error response, or the raw
+// body if it has none.
+func errorMessage(body []byte) string {
+	var e struct {
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(body, &e) == nil && e.Message != "" {
+		return e.Message
+	}
+	return string(body)
+}
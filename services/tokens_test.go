@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// setQuota records a quota for a token as if reported by a response.
+func setQuota(t *poolToken, remaining int, reset time.Time) {
+	h := make(http.Header)
+	h.Set("X-RateLimit-Limit", "5000")
+	h.Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+	h.Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+	t.rate.update(h)
+}
+
+func TestNewTokenPool(t *testing.T) {
+	p := newTokenPool([]string{"", "a", "your_github_token_here", "b", "a"}, time.Minute)
+	if len(p.tokens) != 2 || p.tokens[0].value != "a" || p.tokens[1].value != "b" {
+		t.Errorf("tokens = %v, want [a b]", p.tokens)
+	}
+}
+
+func TestTokenPoolPick(t *testing.T) {
+	soon := time.Now().Add(10 * time.Minute)
+	later := time.Now().Add(30 * time.Minute)
+
+	tests := []struct {
+		name      string
+		remaining []int // -1 leaves the quota unknown
+		resets    []time.Time
+		invalid   []int
+		want      int // index of the picked token, -1 for none
+	}{
+		{name: "empty pool", want: -1},
+		{name: "most remaining", remaining: []int{100, 4000, 50}, want: 1},
+		{name: "unknown quota first", remaining: []int{4000, -1}, want: 1},
+		{name: "skips invalid", remaining: []int{100, 4000}, invalid: []int{1}, want: 0},
+		{name: "all invalid", remaining: []int{100, 4000}, invalid: []int{0, 1}, want: -1},
+		{
+			name: "all exhausted, earliest reset", remaining: []int{0, 0},
+			resets: []time.Time{later, soon}, want: 1,
+		},
+		{
+			name: "reset passed counts as unused", remaining: []int{4000, 0},
+			resets: []time.Time{later, time.Now().Add(-time.Minute)}, want: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		values := make([]string, len(tt.remaining))
+		for i := range values {
+			values[i] = "token" + strconv.Itoa(i)
+		}
+		p := newTokenPool(values, time.Minute)
+		for i, remaining := range tt.remaining {
+			if remaining < 0 {
+				continue
+			}
+			reset := later
+			if tt.resets != nil {
+				reset = tt.resets[i]
+			}
+			setQuota(p.tokens[i], remaining, reset)
+		}
+		for _, i := range tt.invalid {
+			p.invalidate(p.tokens[i], "401 - Bad credentials")
+		}
+
+		got := p.pick()
+		switch {
+		case tt.want < 0 && got != nil:
+			t.Errorf("%s: pick() = %s, want nil", tt.name, got.value)
+		case tt.want >= 0 && got != p.tokens[tt.want]:
+			t.Errorf("%s: pick() = %v, want %s", tt.name, got, p.tokens[tt.want].value)
+		case got != nil && got.requests != 1:
+			t.Errorf("%s: requests = %d, want 1", tt.name, got.requests)
+		}
+	}
+}
+
+func TestTokenPoolInvalidate(t *testing.T) {
+	p := newTokenPool([]string{"token_a", "token_b"}, time.Minute)
+	a, b := p.tokens[0], p.tokens[1]
+
+	p.invalidate(a, "401 - Bad credentials")
+	since := a.invalidSince
+	if since == nil {
+		t.Fatal("invalidSince not set")
+	}
+	for i := 0; i < 3; i++ {
+		if got := p.pick(); got != b {
+			t.Fatalf("pick() = %v, want token_b", got)
+		}
+	}
+
+	// A repeated rejection keeps the original time but updates the error
+	p.invalidate(a, "401 - Token expired")
+	if a.invalidSince != since || a.lastError != "401 - Token expired" {
+		t.Errorf("invalidSince = %v, lastError = %q", a.invalidSince, a.lastError)
+	}
+
+	health := p.health("github.com")
+	if health[0].Status != TokenStatusInvalid || health[0].Token != "...en_a" || health[0].Host != "github.com" {
+		t.Errorf("health[0] = %+v", health[0])
+	}
+	if health[1].Status != TokenStatusValid || health[1].Requests != 3 {
+		t.Errorf("health[1] = %+v", health[1])
+	}
+
+	p.validate(a)
+	if a.invalidSince != nil || a.lastError != "" {
+		t.Errorf("validate: invalidSince = %v, lastError = %q", a.invalidSince, a.lastError)
+	}
+	p.invalidate(b, "401 - Bad credentials")
+	if got := p.pick(); got != a {
+		t.Errorf("pick() after validate = %v, want token_a", got)
+	}
+}
+
+func TestDoInvalidToken(t *testing.T) {
+	var used []string
+	g, _, _ := newTestService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		used = append(used, r.Header.Get("Authorization"))
+		if r.Header.Get("Authorization") == "token token_a" {
+			http.Error(w, `{"message": "Bad credentials"}`, http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	g.tokens = newTokenPool([]string{"token_a", "token_b"}, time.Minute)
+	setQuota(g.tokens.tokens[0], 4000, time.Now().Add(time.Hour))
+	setQuota(g.tokens.tokens[1], 100, time.Now().Add(time.Hour))
+
+	// The rejected token is taken out of rotation and the request repeated
+	for i := 0; i < 2; i++ {
+		resp, err := g.fetch(context.Background(), g.apiURL+"/repos/o/r", jsonMediaType, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("status = %d, want 200", resp.StatusCode)
+		}
+	}
+	want := []string{"token token_a", "token token_b", "token token_b"}
+	if strings.Join(used, ",") != strings.Join(want, ",") {
+		t.Errorf("authorizations = %v, want %v", used, want)
+	}
+	if g.tokens.tokens[0].invalidSince == nil {
+		t.Error("token_a not marked invalid")
+	}
+}
@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// defaultConcurrency is used when github.concurrency is not configured.
+const defaultConcurrency = 4
+
+// runPool calls fn for every repository with at most g.concurrency calls in
+// flight and collects the results, in input order, into a report. Once ctx is
+// canceled the remaining repositories are reported as canceled.
+func (g *GitHubService) runPool(ctx context.Context, repoURLs []string, observe SyncObserver, fn func(ctx context.Context, repoURL string) *RepoSyncResult) *SyncReport {
+	report := &SyncReport{
+		Results:   make([]*RepoSyncResult, len(repoURLs)),
+		StartedAt: time.Now(),
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < g.concurrency && w < len(repoURLs); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				name := g.repositoryName(repoURLs[i])
+				if ctx.Err() != nil {
+					report.Results[i] = &RepoSyncResult{
+						Repository: name,
+						Status:     SyncStatusCanceled,
+						Error:      ctx.Err().Error(),
+					}
+					continue
+				}
+
+				observe.emit(SyncEvent{Type: EventRepositoryStarted, Repository: name})
+				start := time.Now()
+				result := fn(ctx, repoURLs[i])
+				result.Duration = time.Since(start)
+				result.DurationMS = result.Duration.Milliseconds()
+				report.Results[i] = result
+
+				event := SyncEvent{Type: EventRepositoryFinished, Repository: name, Result: result}
+				if result.Status == SyncStatusFailed {
+					event.Type = EventRepositoryFailed
+				}
+				observe.emit(event)
+			}
+		}()
+	}
+
+	for i := range repoURLs {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	for _, result := range report.Results {
+		report.add(result)
+	}
+	report.finish(ctx)
+	return report
+}